@@ -0,0 +1,241 @@
+package rulekit
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"time"
+)
+
+// arith applies an arithmetic operator to two numeric values.
+//
+// Integers are computed as int64, falling back to uint64 when the result is out of range for int64 (the same way
+// integer literals are parsed). If either operand is a float, the operation is performed in float64.
+// Integer division truncates toward zero. Time values are handled by arithTime.
+func arith(left any, op int, right any) (any, error) {
+	if v, ok, err := arithTime(left, op, right); ok {
+		return v, err
+	}
+
+	// numbers are promoted the same way as for comparisons
+	l, r, ok := promoteNumbers(left, right)
+	if !ok {
+		return nil, fmt.Errorf("%w: operator %s not defined on %T and %T", ErrInvalidOperation, operatorToString(op), left, right)
+	}
+	if l, ok := l.(float64); ok {
+		return arithFloat(l, op, r.(float64))
+	}
+
+	if l, ok := l.(int64); ok {
+		if r, ok := r.(int64); ok {
+			v, ok, err := arithInt64(l, op, r)
+			if err != nil {
+				return nil, err
+			} else if ok {
+				return v, nil
+			}
+		}
+	}
+
+	// mixed signed/unsigned operands or an int64 overflow
+	return arithBigInt(toBigInt(l), op, toBigInt(r))
+}
+
+func toBigInt(v any) *big.Int {
+	switch v := v.(type) {
+	case int64:
+		return big.NewInt(v)
+	case uint64:
+		return new(big.Int).SetUint64(v)
+	}
+	return new(big.Int)
+}
+
+func arithFloat(l float64, op int, r float64) (any, error) {
+	switch op {
+	case op_ADD:
+		return l + r, nil
+	case op_SUB:
+		return l - r, nil
+	case op_MUL:
+		return l * r, nil
+	case op_DIV:
+		if r == 0 {
+			return nil, errDivisionByZero
+		}
+		return l / r, nil
+	case op_MOD:
+		if r == 0 {
+			return nil, errDivisionByZero
+		}
+		return math.Mod(l, r), nil
+	}
+	return nil, errUnknownArithOperator(op)
+}
+
+// arithInt64 returns ok=false if the result overflows int64.
+func arithInt64(l int64, op int, r int64) (v int64, ok bool, err error) {
+	switch op {
+	case op_ADD:
+		v = l + r
+		// overflow if both operands have the same sign and the result's sign differs
+		return v, (l >= 0) != (r >= 0) || (v >= 0) == (l >= 0), nil
+	case op_SUB:
+		v = l - r
+		return v, (l >= 0) == (r >= 0) || (v >= 0) == (l >= 0), nil
+	case op_MUL:
+		if l == 0 || r == 0 {
+			return 0, true, nil
+		}
+		hi, lo := bits.Mul64(absUint64(l), absUint64(r))
+		if hi != 0 || lo > math.MaxInt64 {
+			return 0, false, nil
+		}
+		v = l * r
+		return v, true, nil
+	case op_DIV, op_MOD:
+		if r == 0 {
+			return 0, false, errDivisionByZero
+		}
+		if l == math.MinInt64 && r == -1 {
+			return 0, false, nil
+		}
+		if op == op_DIV {
+			return l / r, true, nil
+		}
+		return l % r, true, nil
+	}
+	return 0, false, errUnknownArithOperator(op)
+}
+
+func absUint64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+func arithBigInt(l *big.Int, op int, r *big.Int) (any, error) {
+	v := new(big.Int)
+	switch op {
+	case op_ADD:
+		v.Add(l, r)
+	case op_SUB:
+		v.Sub(l, r)
+	case op_MUL:
+		v.Mul(l, r)
+	case op_DIV, op_MOD:
+		if r.Sign() == 0 {
+			return nil, errDivisionByZero
+		}
+		if op == op_DIV {
+			v.Quo(l, r)
+		} else {
+			v.Rem(l, r)
+		}
+	default:
+		return nil, errUnknownArithOperator(op)
+	}
+
+	switch {
+	case v.IsInt64():
+		return v.Int64(), nil
+	case v.IsUint64():
+		return v.Uint64(), nil
+	}
+	return nil, fmt.Errorf("%w: integer overflow", ErrInvalidOperation)
+}
+
+// arithTime applies an arithmetic operator to time values. handled is false if neither operand is a time value.
+//
+//   - time.Time ± time.Duration = time.Time
+//   - time.Time - time.Time = time.Duration
+//   - time.Duration ± time.Duration = time.Duration
+//   - time.Duration % time.Duration = time.Duration
+//   - time.Duration * number, time.Duration / number = time.Duration
+//   - time.Duration / time.Duration = float64
+func arithTime(left any, op int, right any) (val any, handled bool, err error) {
+	switch l := left.(type) {
+	case time.Time:
+		switch r := right.(type) {
+		case time.Duration:
+			switch op {
+			case op_ADD:
+				return l.Add(r), true, nil
+			case op_SUB:
+				return l.Add(-r), true, nil
+			}
+		case time.Time:
+			if op == op_SUB {
+				return l.Sub(r), true, nil
+			}
+		}
+
+	case time.Duration:
+		switch r := right.(type) {
+		case time.Time:
+			if op == op_ADD {
+				return r.Add(l), true, nil
+			}
+		case time.Duration:
+			switch op {
+			case op_ADD, op_SUB, op_MOD:
+				val, err = toDuration(arith(int64(l), op, int64(r)))
+				return val, true, err
+			case op_DIV:
+				if r == 0 {
+					return nil, true, errDivisionByZero
+				}
+				return float64(l) / float64(r), true, nil
+			}
+		case int, int64, uint, uint64, float32, float64:
+			switch op {
+			case op_MUL, op_DIV:
+				val, err = toDuration(arith(int64(l), op, r))
+				return val, true, err
+			}
+		}
+
+	case int, int64, uint, uint64, float32, float64:
+		r, ok := right.(time.Duration)
+		if !ok {
+			return nil, false, nil
+		}
+		if op == op_MUL {
+			val, err = toDuration(arith(l, op, int64(r)))
+			return val, true, err
+		}
+
+	default:
+		switch right.(type) {
+		case time.Time, time.Duration:
+		default:
+			return nil, false, nil
+		}
+	}
+
+	return nil, true, fmt.Errorf("%w: operator %s not defined on %T and %T", ErrInvalidOperation, operatorToString(op), left, right)
+}
+
+// toDuration converts the numeric result of arith to a time.Duration.
+func toDuration(v any, err error) (time.Duration, error) {
+	if err != nil {
+		return 0, err
+	}
+	switch v := v.(type) {
+	case int64:
+		return time.Duration(v), nil
+	case float64:
+		if v >= math.MinInt64 && v < math.MaxInt64 {
+			return time.Duration(v), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: duration overflow", ErrInvalidOperation)
+}
+
+var errDivisionByZero = fmt.Errorf("%w: division by zero", ErrInvalidOperation)
+
+func errUnknownArithOperator(op int) error {
+	return fmt.Errorf("%w: unknown arithmetic operator %s", ErrInvalidOperation, operatorToString(op))
+}
@@ -0,0 +1,84 @@
+package rulekit
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArith(t *testing.T) {
+	tcs := []struct {
+		l, r any
+		op   int
+		want any
+	}{
+		{int64(1), int64(2), op_ADD, int64(3)},
+		{int(1), uint(2), op_SUB, int64(-1)},
+		{int64(7), int64(2), op_DIV, int64(3)},
+		{int64(-7), int64(2), op_DIV, int64(-3)},
+		{int64(-7), int64(2), op_MOD, int64(-1)},
+		{int64(3), float64(0.5), op_MUL, float64(1.5)},
+		{float32(1.5), int64(1), op_ADD, float64(2.5)},
+		{float64(7.5), int64(2), op_MOD, float64(1.5)},
+		// results out of range for int64 fall back to uint64
+		{int64(math.MaxInt64), int64(1), op_ADD, uint64(math.MaxInt64 + 1)},
+		{uint64(math.MaxUint64), int64(-1), op_ADD, uint64(math.MaxUint64 - 1)},
+		{uint64(math.MaxUint64), uint64(math.MaxUint64), op_SUB, int64(0)},
+		{int64(math.MinInt64), int64(-1), op_DIV, uint64(math.MaxInt64 + 1)},
+		{int64(math.MaxInt64), int64(2), op_MUL, uint64(math.MaxUint64 - 1)},
+		{int64(math.MinInt64), int64(1), op_MUL, int64(math.MinInt64)},
+	}
+	for _, tc := range tcs {
+		got, err := arith(tc.l, tc.op, tc.r)
+		require.NoErrorf(t, err, "%v %s %v", tc.l, operatorToString(tc.op), tc.r)
+		require.Equalf(t, tc.want, got, "%v %s %v", tc.l, operatorToString(tc.op), tc.r)
+	}
+
+	for _, tc := range []struct {
+		l, r any
+		op   int
+		err  string
+	}{
+		{int64(1), int64(0), op_DIV, "invalid operation: division by zero"},
+		{float64(1), int64(0), op_MOD, "invalid operation: division by zero"},
+		{int64(math.MinInt64), int64(1), op_SUB, "invalid operation: integer overflow"},
+		{uint64(math.MaxUint64), int64(1), op_ADD, "invalid operation: integer overflow"},
+		{"1", int64(1), op_ADD, "invalid operation: operator + not defined on string and int64"},
+		{int64(1), nil, op_MUL, "invalid operation: operator * not defined on int64 and <nil>"},
+	} {
+		_, err := arith(tc.l, tc.op, tc.r)
+		require.EqualError(t, err, tc.err)
+		require.True(t, errors.Is(err, ErrInvalidOperation))
+	}
+}
+
+func TestArith_int64Overflow(t *testing.T) {
+	// every int64 result must match the big.Int fallback
+	vals := []int64{0, 1, -1, 2, -2, math.MaxInt64, math.MinInt64, math.MaxInt64 / 2, math.MinInt64 / 2, math.MaxInt32}
+	for _, l := range vals {
+		for _, r := range vals {
+			for _, op := range []int{op_ADD, op_SUB, op_MUL, op_DIV, op_MOD} {
+				got, gotErr := arith(l, op, r)
+				want, wantErr := arithBigInt(toBigInt(l), op, toBigInt(r))
+				require.Equalf(t, wantErr, gotErr, "%d %s %d", l, operatorToString(op), r)
+				require.Equalf(t, want, got, "%d %s %d", l, operatorToString(op), r)
+			}
+		}
+	}
+}
+
+func TestArith_matchesCmpNumber(t *testing.T) {
+	// arithmetic and comparisons promote numbers the same way, so x - y has the sign of cmpNumber(x, y)
+	for _, x := range cmpMatrix {
+		for _, y := range cmpMatrix {
+			diff, err := arith(x, op_SUB, y)
+			if err != nil {
+				// integer overflow or a non-numeric operand
+				continue
+			}
+			require.Equalf(t, cmpNumber(x, y), cmpNumber(diff, 0), "%T(%v) - %T(%v)", x, x, y, y)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package rulekit
 import (
 	"fmt"
 	"net"
+	"time"
 )
 
 func compare(left any, op int, right any) (ret bool) {
@@ -13,7 +14,7 @@ func compare(left any, op int, right any) (ret bool) {
 			debugResult(ret, "╰ cmp[]", "", left, op, right)
 		}()
 
-		if op == op_CONTAINS {
+		if op == op_CONTAINS || op == op_ICONTAINS {
 			// the contains operator does not support arrays on the right side.
 			// TODO: return error
 			return false
@@ -28,6 +29,34 @@ func compare(left any, op int, right any) (ret bool) {
 		debugResult(ret, "╰ cmp", "", left, op, right)
 	}()
 
+	// []any ? any
+	//      -> run the comparison for each element in the left array. this happens before
+	//         the null check so that `arr contains null` matches null elements.
+	if leftArr, ok := left.([]any); ok {
+		return compareSlice(leftArr, op, func(lv any, op int) bool {
+			return compare(lv, op, right)
+		})
+	}
+
+	// null ? any
+	//      -> null is only equal to null
+	if left == nil || right == nil {
+		switch op {
+		case op_EQ:
+			return left == nil && right == nil
+		case op_NE:
+			return left != nil || right != nil
+		}
+		return false
+	}
+
+	switch left.(type) {
+	case string, []string:
+	default:
+		// only strings are compared case-insensitively
+		op = caseSensitiveOp(op)
+	}
+
 	// the left value type determines the comparison logic
 	switch lv := left.(type) {
 	case string:
@@ -97,11 +126,21 @@ func compare(left any, op int, right any) (ret bool) {
 		// mac ? any
 		return compareMac(lv, op, right)
 
-	case []any:
-		// []any ? any
-		return compareSlice(lv, op, func(lv any, op int) bool {
-			return compare(lv, op, right)
-		})
+	case MACPrefix:
+		// mac prefix ? any
+		return compareMACPrefix(lv, op, right)
+
+	case time.Time, time.Duration:
+		// time ? any
+		return compareTime(lv, op, right)
+
+	case Semver:
+		// semver ? any
+		return compareSemver(lv, op, right)
+
+	case Range:
+		// range ? any
+		return compareRange(lv, op, right)
 	}
 
 	return false
@@ -119,18 +158,19 @@ func debugResult(result bool, prefix string, lname string, lv any, op int, rv an
 }
 
 func compareSlice[T any](slice []T, op int, fn func(el T, op int) bool) bool {
-	if op == op_NE {
+	switch op {
+	case op_NE:
 		// []T != any
 		//      -> check if NONE of the slice elements are equal to the right value.
 		//         this is equivalent to !([]T == any)
 		return !compareSlice(slice, op_EQ, fn)
-	}
-
-	if op == op_CONTAINS {
+	case op_INE:
+		return !compareSlice(slice, op_IEQ, fn)
+	case op_CONTAINS, op_ICONTAINS:
 		// []T contains any
 		//      -> check if any of the slice elements are equal to the right value.
 		//         e.g. we don't want to do any substring matching here.
-		op = op_EQ
+		op = containsToEqOp(op)
 	}
 
 	for _, el := range slice {
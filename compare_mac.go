@@ -2,7 +2,6 @@ package rulekit
 
 import (
 	"net"
-	"strings"
 )
 
 func compareMac(left net.HardwareAddr, op int, right any) (ret bool) {
@@ -16,10 +15,13 @@ func compareMac(left net.HardwareAddr, op int, right any) (ret bool) {
 	case HexString:
 		// mac ? hex
 		// in this case, treat the hex string as a literal
-		return compareStringString(strings.ToLower(left.String()), op, strings.ToLower(right.String()))
+		return compareStringString(left.String(), caseInsensitiveOp(op), right.String())
+	case MACPrefix:
+		// mac ? mac prefix
+		return compareMACPrefix(right, op, left)
 	case string:
 		// mac ? string
-		return compareStringString(strings.ToLower(left.String()), op, strings.ToLower(right))
+		return compareStringString(left.String(), caseInsensitiveOp(op), right)
 	}
 	return false
 }
@@ -1,72 +1,97 @@
 package rulekit
 
-import "cmp"
+import (
+	"cmp"
+	"math"
+	"time"
+)
 
 func compareNumber(left any, op int, right any) (ret bool) {
 	defer func() {
 		debugResult(ret, "│ cmpNum", "", left, op, right)
 	}()
 
+	switch right.(type) {
+	case time.Time, time.Duration:
+		// number ? time
+		return compareWithOp(cmpTime(left, right), op)
+	}
 	return compareWithOp(cmpNumber(left, right), op)
 }
 
 func cmpNumber(left any, right any) int {
-	switch left := left.(type) {
-	case int:
-		return cmpNumber(int64(left), right)
+	l, r, ok := promoteNumbers(left, right)
+	if !ok {
+		return cmpResultNotComparable
+	}
+	switch l := l.(type) {
+	case float64:
+		return cmp.Compare(l, r.(float64))
 	case int64:
-		switch right := right.(type) {
-		case int:
-			return cmp.Compare(left, int64(right))
+		switch r := r.(type) {
 		case int64:
-			return cmp.Compare(left, right)
-		case uint:
-			return compareSignedUnsigned(left, uint64(right))
+			return cmp.Compare(l, r)
 		case uint64:
-			return compareSignedUnsigned(left, right)
-		case float32:
-			return cmp.Compare(float64(left), float64(right))
-		case float64:
-			return cmp.Compare(float64(left), right)
+			return compareSignedUnsigned(l, r)
 		}
-
-	case uint:
-		return cmpNumber(uint64(left), right)
 	case uint64:
-		switch right := right.(type) {
-		case int:
-			return compareUnsignedSigned(left, int64(right))
+		switch r := r.(type) {
 		case int64:
-			return compareUnsignedSigned(left, right)
-		case uint:
-			return cmp.Compare(left, uint64(right))
+			return compareUnsignedSigned(l, r)
 		case uint64:
-			return cmp.Compare(left, right)
-		case float32:
-			return cmp.Compare(float64(left), float64(right))
-		case float64:
-			return cmp.Compare(float64(left), right)
+			return cmp.Compare(l, r)
 		}
+	}
+	return cmpResultNotComparable
+}
+
+// promoteNumbers converts two numbers to a common representation, which is shared by comparisons and arithmetic.
+// Integers are converted to int64 or uint64 and floats to float64. If either value is a float, both are
+// converted to float64. ok is false if either value is not a number.
+func promoteNumbers(left any, right any) (l any, r any, ok bool) {
+	l, lok := normalizeNumber(left)
+	r, rok := normalizeNumber(right)
+	if !lok || !rok {
+		return nil, nil, false
+	}
+
+	_, lfloat := l.(float64)
+	_, rfloat := r.(float64)
+	if lfloat || rfloat {
+		return toFloat64(l), toFloat64(r), true
+	}
+	return l, r, true
+}
 
+// normalizeNumber converts numeric values to int64, uint64 or float64.
+func normalizeNumber(v any) (any, bool) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint:
+		return uint64(v), true
+	case uint64:
+		return v, true
 	case float32:
-		return cmpNumber(float64(left), right)
+		return float64(v), true
 	case float64:
-		switch right := right.(type) {
-		case float32:
-			return cmp.Compare(left, float64(right))
-		case float64:
-			return cmp.Compare(left, right)
-		case int:
-			return cmp.Compare(left, float64(right))
-		case int64:
-			return cmp.Compare(left, float64(right))
-		case uint:
-			return cmp.Compare(left, float64(right))
-		case uint64:
-			return cmp.Compare(left, float64(right))
-		}
+		return v, true
 	}
-	return cmpResultNotComparable
+	return nil, false
+}
+
+func toFloat64(v any) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return math.NaN()
 }
 
 // Helper function for comparing signed vs unsigned numbers
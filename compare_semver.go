@@ -0,0 +1,32 @@
+package rulekit
+
+func compareSemver(left Semver, op int, right any) (ret bool) {
+	defer func() {
+		debugResult(ret, "│ cmpSemver", "", left, op, right)
+	}()
+	return compareWithOp(cmpSemver(left, right), op)
+}
+
+// cmpSemver compares two semantic versions. Strings are parsed as semantic versions.
+func cmpSemver(left any, right any) int {
+	l, ok := toSemver(left)
+	if !ok {
+		return cmpResultNotComparable
+	}
+	r, ok := toSemver(right)
+	if !ok {
+		return cmpResultNotComparable
+	}
+	return l.Compare(r)
+}
+
+func toSemver(v any) (Semver, bool) {
+	switch v := v.(type) {
+	case Semver:
+		return v, true
+	case string:
+		sv, err := ParseSemver(v)
+		return sv, err == nil
+	}
+	return Semver{}, false
+}
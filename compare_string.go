@@ -4,6 +4,8 @@ import (
 	"net"
 	"regexp"
 	"strings"
+	"time"
+	"unicode"
 )
 
 func compareString(left string, op int, right any) (ret bool) {
@@ -17,15 +19,33 @@ func compareString(left string, op int, right any) (ret bool) {
 	case *regexp.Regexp:
 		// string ? regexp
 		return compareStringRegex(left, op, right)
+	case Glob:
+		// string ? glob
+		return compareStringGlob(left, op, right)
 	case net.IP:
 		// string ? ip
 		return compareStringString(left, op, right.String())
 	case *net.IPNet:
 		// string ? ipnet
 		return compareStringString(left, op, right.String())
+	case net.HardwareAddr:
+		// string ? mac
+		if mac, ok := toMAC(left); ok {
+			return compareMac(mac, op, right)
+		}
+		return false
+	case MACPrefix:
+		// string ? mac prefix
+		return compareMACPrefix(right, op, left)
 	case HexString:
 		// string ? hex
-		return compareBytesBytes([]byte(left), op, right.Bytes)
+		return compareBytesBytes([]byte(left), caseSensitiveOp(op), right.Bytes)
+	case time.Time, time.Duration:
+		// string ? time
+		return compareWithOp(cmpTime(left, right), caseSensitiveOp(op))
+	case Semver:
+		// string ? semver
+		return compareWithOp(cmpSemver(left, right), caseSensitiveOp(op))
 	}
 	return false
 }
@@ -41,6 +61,12 @@ func compareStringString(left string, op int, right string) (ret bool) {
 		return left != right
 	case op_CONTAINS:
 		return strings.Contains(left, right)
+	case op_IEQ:
+		return strings.EqualFold(left, right)
+	case op_INE:
+		return !strings.EqualFold(left, right)
+	case op_ICONTAINS:
+		return strings.Contains(foldCase(left), foldCase(right))
 	}
 	return false
 }
@@ -49,7 +75,8 @@ func compareStringRegex(left string, op int, right *regexp.Regexp) (ret bool) {
 	defer func() {
 		debugResult(ret, "│ cmpStrRegex", "", left, op, right)
 	}()
-	switch op {
+	// case sensitivity is controlled by the regex itself
+	switch caseSensitiveOp(op) {
 	case op_EQ, op_CONTAINS:
 		return right.MatchString(left)
 	case op_NE:
@@ -58,17 +85,31 @@ func compareStringRegex(left string, op int, right *regexp.Regexp) (ret bool) {
 	return false
 }
 
+func compareStringGlob(left string, op int, right Glob) (ret bool) {
+	defer func() {
+		debugResult(ret, "│ cmpStrGlob", "", left, op, right)
+	}()
+	switch caseSensitiveOp(op) {
+	case op_EQ, op_CONTAINS:
+		return right.Match(left)
+	case op_NE:
+		return !right.Match(left)
+	}
+	return false
+}
+
 func compareStringSlice(left []string, op int, right any) (ret bool) {
 	defer func() {
 		debugResult(ret, "│ cmp[]Str", "", left, op, right)
 	}()
-	if op == op_CONTAINS {
+	switch op {
+	case op_CONTAINS, op_ICONTAINS:
 		// possible options:
 		// []string{...} contains string
 		// 		-> check if the slice contains the string, not if any of the slice elements contains the string as a substring
 		// []string{...} contains regexp
 		// 		-> check if the slice contains any element that matches the regexp
-		op = op_EQ
+		op = containsToEqOp(op)
 	}
 
 	switch right := right.(type) {
@@ -88,6 +129,61 @@ func compareStringSlice(left []string, op int, right any) (ret bool) {
 			}
 		}
 		return false
+	case Glob:
+		// []string{...} ? glob
+		for _, fv := range left {
+			if compareStringGlob(fv, op, right) {
+				return true
+			}
+		}
+		return false
 	}
 	return false
 }
+
+// foldCase maps each rune of s to the smallest rune of its Unicode case folding orbit.
+// Two strings are equal under simple case folding (strings.EqualFold) iff their folded forms are equal.
+func foldCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		folded := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			folded = min(folded, f)
+		}
+		return folded
+	}, s)
+}
+
+// caseSensitiveOp returns the case-sensitive counterpart of a case-insensitive operator.
+func caseSensitiveOp(op int) int {
+	switch op {
+	case op_IEQ:
+		return op_EQ
+	case op_INE:
+		return op_NE
+	case op_ICONTAINS:
+		return op_CONTAINS
+	}
+	return op
+}
+
+// caseInsensitiveOp returns the case-insensitive counterpart of an equality or contains operator.
+func caseInsensitiveOp(op int) int {
+	switch op {
+	case op_EQ:
+		return op_IEQ
+	case op_NE:
+		return op_INE
+	case op_CONTAINS:
+		return op_ICONTAINS
+	}
+	return op
+}
+
+// containsToEqOp returns the equality operator used to check a contains operator
+// against the elements of a slice.
+func containsToEqOp(op int) int {
+	if op == op_ICONTAINS {
+		return op_IEQ
+	}
+	return op_EQ
+}
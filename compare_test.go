@@ -6,7 +6,9 @@ import (
 	"math"
 	"reflect"
 	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -109,3 +111,36 @@ func BenchmarkCmpNumber(b *testing.B) {
 		}
 	}
 }
+
+func TestCmpTime(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		x, y any
+		want int
+	}{
+		{ts, ts.Add(time.Second), cmpResultLess},
+		{ts, ts.In(time.FixedZone("", 3600)), cmpResultEqual},
+		{ts, "2026-01-01T01:00:00+01:00", cmpResultEqual},
+		{ts, ts.Unix(), cmpResultEqual},
+		{ts, float64(ts.Unix()) + 0.5, cmpResultLess},
+		{ts, "not a time", cmpResultNotComparable},
+		{ts, time.Second, cmpResultNotComparable},
+		{time.Minute, 2 * time.Minute, cmpResultLess},
+		{time.Minute, "1m", cmpResultEqual},
+		{time.Minute, 60, cmpResultEqual},
+		{time.Minute, uint64(59), cmpResultGreater},
+		{1500 * time.Millisecond, 1.5, cmpResultEqual},
+		{time.Minute, true, cmpResultNotComparable},
+	} {
+		require.Equalf(t, tc.want, cmpTime(tc.x, tc.y), "%v ? %v", tc.x, tc.y)
+		require.Equalf(t, reversedCmpResult(tc.want), cmpTime(tc.y, tc.x), "%v ? %v", tc.y, tc.x)
+	}
+}
+
+func TestFoldCase(t *testing.T) {
+	for _, s := range []string{"GET", "Straße", "ΟΔΥΣΣΕΥΣ", "K", "ǅ", "123-abc"} {
+		require.Equalf(t, foldCase(s), foldCase(strings.ToLower(s)), "%q", s)
+		require.Equalf(t, foldCase(s), foldCase(strings.ToUpper(s)), "%q", s)
+	}
+	require.NotEqual(t, foldCase("a"), foldCase("b"))
+}
@@ -0,0 +1,75 @@
+package rulekit
+
+import (
+	"cmp"
+	"time"
+)
+
+func compareTime(left any, op int, right any) (ret bool) {
+	defer func() {
+		debugResult(ret, "│ cmpTime", "", left, op, right)
+	}()
+
+	return compareWithOp(cmpTime(left, right), op)
+}
+
+// cmpTime compares a time.Time or time.Duration with another value.
+//
+//   - time.Time ? time.Time
+//   - time.Time ? number: the number is interpreted as unix seconds
+//   - time.Duration ? time.Duration
+//   - time.Duration ? number: the number is interpreted as seconds
+//   - time.Time/time.Duration ? string: the string is parsed as an RFC 3339 timestamp or a duration
+//
+// Either side may hold the time value.
+func cmpTime(left any, right any) int {
+	switch left := left.(type) {
+	case time.Time:
+		switch right := right.(type) {
+		case time.Time:
+			return left.Compare(right)
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, right)
+			if err != nil {
+				return cmpResultNotComparable
+			}
+			return left.Compare(t)
+		case int, int64, uint, uint64, float32, float64:
+			return cmpNumber(unixSeconds(left), right)
+		}
+
+	case time.Duration:
+		switch right := right.(type) {
+		case time.Duration:
+			return cmp.Compare(left, right)
+		case string:
+			d, err := time.ParseDuration(right)
+			if err != nil {
+				return cmpResultNotComparable
+			}
+			return cmp.Compare(left, d)
+		case int, int64, uint, uint64, float32, float64:
+			return cmpNumber(left.Seconds(), right)
+		}
+
+	default:
+		switch right.(type) {
+		case time.Time, time.Duration:
+			// any ? time
+			return reverseCmpResult(cmpTime(right, left))
+		}
+	}
+	return cmpResultNotComparable
+}
+
+// unixSeconds returns t as fractional seconds since the unix epoch.
+func unixSeconds(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/float64(time.Second)
+}
+
+func reverseCmpResult(result int) int {
+	if result == cmpResultNotComparable {
+		return result
+	}
+	return -result
+}
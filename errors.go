@@ -59,6 +59,16 @@ func coalesceErrs(errs ...error) error {
 
 var ErrInvalidOperation = errors.New("invalid operation")
 
+type ErrIndexOutOfRange struct {
+	// Index is the index as it was given, e.g. an int64 or a uint64
+	Index any
+	Len   int
+}
+
+func (e *ErrIndexOutOfRange) Error() string {
+	return fmt.Sprintf("index out of range [%v] with length %d", e.Index, e.Len)
+}
+
 type ErrInvalidFunctionArg struct {
 	Name     string
 	Expected string
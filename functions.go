@@ -11,9 +11,8 @@ type FunctionValue struct {
 }
 
 func (f *FunctionValue) Eval(ctx *Ctx) Result {
-	if fn, ok := StdlibFuncs[f.fn]; ok {
-		return f.eval(fn, ctx)
-	} else if fn, ok := ctx.Functions[f.fn]; ok {
+	// custom functions and macros take precedence over stdlib functions of the same name
+	if fn, ok := ctx.Functions[f.fn]; ok {
 		return f.eval(fn, ctx)
 	} else if macro, ok := ctx.Macros[f.fn]; ok {
 		if len(f.args.vals) > 0 {
@@ -23,6 +22,8 @@ func (f *FunctionValue) Eval(ctx *Ctx) Result {
 			}
 		}
 		return macro.Eval(ctx)
+	} else if fn, ok := StdlibFuncs[f.fn]; ok {
+		return f.eval(fn, ctx)
 	}
 
 	return Result{
@@ -41,6 +42,10 @@ func (f *FunctionValue) eval(fn *Function, ctx *Ctx) Result {
 
 	argMap := make(map[string]any, len(f.args.vals))
 	for i, arg := range f.args.vals {
+		if v := fn.Args[i].Var; v != "" {
+			argMap[fn.Args[i].Name] = newPredicate(arg, v, ctx)
+			continue
+		}
 		res := arg.Eval(ctx)
 		if !res.Ok() {
 			return res
@@ -66,7 +71,13 @@ func newFunctionValue(fn string, args []Rule) *FunctionValue {
 	}
 }
 
+// ValidateStdlibFnArgs checks the number of arguments to a reserved stdlib function when the rule is parsed.
+// Other stdlib functions may be overridden by a custom function or macro that takes a different number of
+// arguments, so their arguments are checked when the function is evaluated.
 func (f *FunctionValue) ValidateStdlibFnArgs() error {
+	if !reservedFuncs[f.fn] {
+		return nil
+	}
 	if stdlibFn, ok := StdlibFuncs[f.fn]; ok {
 		if len(stdlibFn.Args) != len(f.args.vals) {
 			return fmt.Errorf("function %q expects %d arguments, got %d", f.fn, len(stdlibFn.Args), len(f.args.vals))
@@ -86,6 +97,21 @@ type Function struct {
 
 type FunctionArg struct {
 	Name string
+	// Var makes the argument a predicate. Rather than being evaluated before the call, the argument is passed
+	// to Eval as a Predicate, which evaluates it with Var bound to a value. Like the loop variable of a
+	// quantifier, Var shadows KV keys with the same name, e.g. `count_if(items, x > 5)` with Var "x".
+	Var string
+}
+
+// Predicate evaluates an argument declared with FunctionArg.Var, binding v to the variable.
+type Predicate func(v any) Result
+
+func newPredicate(arg Rule, name string, ctx *Ctx) Predicate {
+	return func(v any) Result {
+		inner := *ctx
+		inner.scope = &scope{name: name, value: v, parent: ctx.scope}
+		return arg.Eval(&inner)
+	}
 }
 
 func IndexFuncArg[T any](args map[string]any, name string) (T, error) {
@@ -1,8 +1,23 @@
 package rulekit
 
 import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"hash/crc32"
+	"math"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 var StdlibFuncs = map[string]*Function{
@@ -26,4 +41,1206 @@ var StdlibFuncs = map[string]*Function{
 			}
 		},
 	},
+	"ends_with": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "suffix"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := IndexFuncArg[any](args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			suffix, err := IndexFuncArg[any](args, "suffix")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			return Result{
+				Value: strings.HasSuffix(fmt.Sprint(value), fmt.Sprint(suffix)),
+			}
+		},
+	},
+	"lower": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: strings.ToLower(value),
+			}
+		},
+	},
+	"upper": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: strings.ToUpper(value),
+			}
+		},
+	},
+	"trim": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: strings.TrimSpace(value),
+			}
+		},
+	},
+	"len": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := IndexFuncArg[any](args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// strings are measured in characters rather than bytes
+			if s, ok := stringValue(value); ok {
+				return Result{
+					Value: int64(utf8.RuneCountInString(s)),
+				}
+			}
+			switch v := reflect.ValueOf(value); v.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				return Result{
+					Value: int64(v.Len()),
+				}
+			}
+			return Result{Error: &ErrInvalidFunctionArg{
+				Name:     "value",
+				Expected: "string, array or map",
+				Got:      fmt.Sprintf("%T", value),
+			}}
+		},
+	},
+	"substr": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "start"},
+			{Name: "length"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			start, err := indexFuncArgInt(args, "start")
+			if err != nil {
+				return Result{Error: err}
+			}
+			length, err := indexFuncArgInt(args, "length")
+			if err != nil {
+				return Result{Error: err}
+			}
+			if start < 0 || length < 0 {
+				return Result{Error: fmt.Errorf("%w: substr start and length must not be negative", ErrInvalidOperation)}
+			}
+
+			// start and length are in characters and are clamped to the end of the string
+			runes := []rune(value)
+			start = min(start, len(runes))
+			end := start + min(length, len(runes)-start)
+			return Result{
+				Value: string(runes[start:end]),
+			}
+		},
+	},
+	"split": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "separator"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			sep, err := indexFuncArgString(args, "separator")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			parts := strings.Split(value, sep)
+			ret := make([]any, len(parts))
+			for i, part := range parts {
+				ret[i] = part
+			}
+			return Result{
+				Value: ret,
+			}
+		},
+	},
+	"join": {
+		Args: []FunctionArg{
+			{Name: "values"},
+			{Name: "separator"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			sep, err := indexFuncArgString(args, "separator")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			parts := make([]string, len(values))
+			for i, v := range values {
+				parts[i] = fmt.Sprint(v)
+			}
+			return Result{
+				Value: strings.Join(parts, sep),
+			}
+		},
+	},
+	"replace": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "old"},
+			{Name: "new"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			old, err := indexFuncArgString(args, "old")
+			if err != nil {
+				return Result{Error: err}
+			}
+			replacement, err := indexFuncArgString(args, "new")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: strings.ReplaceAll(value, old, replacement),
+			}
+		},
+	},
+	"index_of": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "substr"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			substr, err := indexFuncArgString(args, "substr")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// the index is in characters, or -1 if substr is not present
+			i := strings.Index(value, substr)
+			if i > 0 {
+				i = utf8.RuneCountInString(value[:i])
+			}
+			return Result{
+				Value: int64(i),
+			}
+		},
+	},
+	"contains_any": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "substrs"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			substrs, err := indexFuncArgSlice(args, "substrs")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			for _, substr := range substrs {
+				s, ok := stringValue(substr)
+				if !ok {
+					return Result{Error: &ErrInvalidFunctionArg{
+						Name:     "substrs",
+						Expected: "array of strings",
+						Got:      fmt.Sprintf("array containing %T", substr),
+					}}
+				}
+				if strings.Contains(value, s) {
+					return Result{Value: true}
+				}
+			}
+			return Result{Value: false}
+		},
+	},
+	"regex_extract": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "pattern"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			re, err := indexFuncArgRegexp(args, "pattern")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// the first capture group if the pattern has one, otherwise the whole match. null if there is no match
+			m := re.FindStringSubmatch(value)
+			switch {
+			case m == nil:
+				return Result{Value: nil}
+			case len(m) > 1:
+				return Result{Value: m[1]}
+			}
+			return Result{Value: m[0]}
+		},
+	},
+	"regex_replace": {
+		Args: []FunctionArg{
+			{Name: "value"},
+			{Name: "pattern"},
+			{Name: "replacement"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgString(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			re, err := indexFuncArgRegexp(args, "pattern")
+			if err != nil {
+				return Result{Error: err}
+			}
+			replacement, err := indexFuncArgString(args, "replacement")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// the replacement may reference capture groups, e.g. $1
+			return Result{
+				Value: re.ReplaceAllString(value, replacement),
+			}
+		},
+	},
+	"is_private": {
+		Args: []FunctionArg{
+			{Name: "ip"},
+		},
+		Eval: func(args map[string]any) Result {
+			ip, err := indexFuncArgIPNet(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+			// RFC 1918 and RFC 4193 addresses
+			return Result{
+				Value: networkAll(ip, net.IP.IsPrivate),
+			}
+		},
+	},
+	"is_loopback": {
+		Args: []FunctionArg{
+			{Name: "ip"},
+		},
+		Eval: func(args map[string]any) Result {
+			ip, err := indexFuncArgIPNet(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: networkAll(ip, net.IP.IsLoopback),
+			}
+		},
+	},
+	"is_multicast": {
+		Args: []FunctionArg{
+			{Name: "ip"},
+		},
+		Eval: func(args map[string]any) Result {
+			ip, err := indexFuncArgIPNet(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: networkAll(ip, net.IP.IsMulticast),
+			}
+		},
+	},
+	"ip_version": {
+		Args: []FunctionArg{
+			{Name: "ip"},
+		},
+		Eval: func(args map[string]any) Result {
+			ip, err := indexFuncArgIP(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+			// IPv4-mapped IPv6 addresses are reported as IPv4
+			version := int64(6)
+			if ip.To4() != nil {
+				version = 4
+			}
+			return Result{
+				Value: version,
+			}
+		},
+	},
+	"cidr_contains": {
+		Args: []FunctionArg{
+			{Name: "cidr"},
+			{Name: "ip"},
+		},
+		Eval: func(args map[string]any) Result {
+			cidr, err := indexFuncArgCIDR(args, "cidr")
+			if err != nil {
+				return Result{Error: err}
+			}
+			// the second argument may be an IP address or a CIDR, which must be entirely within the first
+			other, err := indexFuncArgCIDR(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			ones, _ := cidr.Mask.Size()
+			otherOnes, _ := other.Mask.Size()
+			return Result{
+				Value: sameIPFamily(cidr.IP, other.IP) && cidr.Contains(other.IP) && ones <= otherOnes,
+			}
+		},
+	},
+	"cidr_overlaps": {
+		Args: []FunctionArg{
+			{Name: "a"},
+			{Name: "b"},
+		},
+		Eval: func(args map[string]any) Result {
+			a, err := indexFuncArgCIDR(args, "a")
+			if err != nil {
+				return Result{Error: err}
+			}
+			b, err := indexFuncArgCIDR(args, "b")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: sameIPFamily(a.IP, b.IP) && (a.Contains(b.IP) || b.Contains(a.IP)),
+			}
+		},
+	},
+	"mask": {
+		Args: []FunctionArg{
+			{Name: "ip"},
+			{Name: "bits"},
+		},
+		Eval: func(args map[string]any) Result {
+			ip, err := indexFuncArgIP(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+			bits, err := indexFuncArgInt(args, "bits")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			if bits < 0 || bits > len(ip)*8 {
+				return Result{Error: fmt.Errorf("%w: mask of %d bits is out of range for %s", ErrInvalidOperation, bits, ip)}
+			}
+			// the network address of ip, e.g. mask(10.1.2.3, 24) == 10.1.2.0
+			return Result{
+				Value: ip.Mask(net.CIDRMask(bits, len(ip)*8)),
+			}
+		},
+	},
+	"ip_to_int": {
+		Args: []FunctionArg{
+			{Name: "ip"},
+		},
+		Eval: func(args map[string]any) Result {
+			ip, err := indexFuncArgIP(args, "ip")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			ip4 := ip.To4()
+			if ip4 == nil {
+				return Result{Error: fmt.Errorf("%w: IPv6 address %s does not fit in an integer", ErrInvalidOperation, ip)}
+			}
+			return Result{
+				Value: int64(binary.BigEndian.Uint32(ip4)),
+			}
+		},
+	},
+	"base64_decode": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// accept standard and URL-safe encodings, with or without padding
+			str := strings.TrimRight(string(value), "=")
+			for _, enc := range []*base64.Encoding{base64.RawStdEncoding, base64.RawURLEncoding} {
+				if decoded, err := enc.DecodeString(str); err == nil {
+					return Result{
+						Value: string(decoded),
+					}
+				}
+			}
+			return Result{Error: fmt.Errorf("%w: invalid base64 value", ErrInvalidOperation)}
+		},
+	},
+	"base64_encode": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: base64.StdEncoding.EncodeToString(value),
+			}
+		},
+	},
+	"hex_encode": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: hex.EncodeToString(value),
+			}
+		},
+	},
+	"url_decode": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// decodes percent-encoding and `+` as a space
+			decoded, err := url.QueryUnescape(string(value))
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: decoded,
+			}
+		},
+	},
+	"sha256": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			sum := sha256.Sum256(value)
+			return Result{
+				Value: hex.EncodeToString(sum[:]),
+			}
+		},
+	},
+	"md5": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			sum := md5.Sum(value)
+			return Result{
+				Value: hex.EncodeToString(sum[:]),
+			}
+		},
+	},
+	"crc32": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := indexFuncArgBytes(args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+			// IEEE polynomial, as used by gzip and PNG
+			return Result{
+				Value: int64(crc32.ChecksumIEEE(value)),
+			}
+		},
+	},
+	"url_host": {
+		Args: []FunctionArg{
+			{Name: "url"},
+		},
+		Eval: func(args map[string]any) Result {
+			u, err := indexFuncArgURL(args, "url", true)
+			if err != nil {
+				return Result{Error: err}
+			}
+			// without the port or the brackets around an IPv6 address
+			return Result{
+				Value: u.Hostname(),
+			}
+		},
+	},
+	"url_port": {
+		Args: []FunctionArg{
+			{Name: "url"},
+		},
+		Eval: func(args map[string]any) Result {
+			u, err := indexFuncArgURL(args, "url", true)
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			port := u.Port()
+			if port == "" {
+				// the default port of the scheme, or null if unknown
+				if p, ok := defaultPorts[strings.ToLower(u.Scheme)]; ok {
+					return Result{Value: p}
+				}
+				return Result{Value: nil}
+			}
+			n, err := strconv.ParseInt(port, 10, 64)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: n,
+			}
+		},
+	},
+	"url_path": {
+		Args: []FunctionArg{
+			{Name: "url"},
+		},
+		Eval: func(args map[string]any) Result {
+			u, err := indexFuncArgURL(args, "url", false)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: u.Path,
+			}
+		},
+	},
+	"url_scheme": {
+		Args: []FunctionArg{
+			{Name: "url"},
+		},
+		Eval: func(args map[string]any) Result {
+			u, err := indexFuncArgURL(args, "url", true)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: strings.ToLower(u.Scheme),
+			}
+		},
+	},
+	"url_query": {
+		Args: []FunctionArg{
+			{Name: "url"},
+			{Name: "key"},
+		},
+		Eval: func(args map[string]any) Result {
+			u, err := indexFuncArgURL(args, "url", false)
+			if err != nil {
+				return Result{Error: err}
+			}
+			key, err := indexFuncArgString(args, "key")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			query, err := url.ParseQuery(u.RawQuery)
+			if err != nil {
+				return Result{Error: err}
+			}
+			// the first value of the parameter, or null if it is not present
+			values, ok := query[key]
+			if !ok {
+				return Result{Value: nil}
+			}
+			return Result{
+				Value: values[0],
+			}
+		},
+	},
+	"url_fragment": {
+		Args: []FunctionArg{
+			{Name: "url"},
+		},
+		Eval: func(args map[string]any) Result {
+			u, err := indexFuncArgURL(args, "url", false)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: u.Fragment,
+			}
+		},
+	},
+	"count": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: int64(len(values)),
+			}
+		},
+	},
+	"sum": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			sum, err := sumValues(values)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: sum,
+			}
+		},
+	},
+	"min": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			v, err := extremeValue(values, cmpResultLess)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: v,
+			}
+		},
+	},
+	"max": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			v, err := extremeValue(values, cmpResultGreater)
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: v,
+			}
+		},
+	},
+	"avg": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			if len(values) == 0 {
+				// the average of an empty array is undefined
+				return Result{Value: nil}
+			}
+			sum, err := sumValues(values)
+			if err != nil {
+				return Result{Error: err}
+			}
+			// numbers are averaged as float64
+			avg, err := arith(sum, op_DIV, float64(len(values)))
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: avg,
+			}
+		},
+	},
+	"unique": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			// the first occurrence of each value in order, using the same equality as ==
+			ret := []any{}
+			for _, v := range values {
+				if !slices.ContainsFunc(ret, func(el any) bool { return equalValues(el, v) }) {
+					ret = append(ret, v)
+				}
+			}
+			return Result{
+				Value: ret,
+			}
+		},
+	},
+	"first": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			if len(values) == 0 {
+				return Result{Value: nil}
+			}
+			return Result{
+				Value: values[0],
+			}
+		},
+	},
+	"last": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+			if len(values) == 0 {
+				return Result{Value: nil}
+			}
+			return Result{
+				Value: values[len(values)-1],
+			}
+		},
+	},
+	"sort": {
+		Args: []FunctionArg{
+			{Name: "values"},
+		},
+		Eval: func(args map[string]any) Result {
+			values, err := indexFuncArgSlice(args, "values")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			ret := slices.Clone(values)
+			for i := 1; i < len(ret); i++ {
+				if cmpValues(ret[0], ret[i]) == cmpResultNotComparable {
+					return Result{Error: fmt.Errorf("%w: cannot compare %T and %T", ErrInvalidOperation, ret[0], ret[i])}
+				}
+			}
+			slices.SortStableFunc(ret, cmpValues)
+			return Result{
+				Value: ret,
+			}
+		},
+	},
+	"intersects": {
+		Args: []FunctionArg{
+			{Name: "a"},
+			{Name: "b"},
+		},
+		Eval: func(args map[string]any) Result {
+			a, err := indexFuncArgSlice(args, "a")
+			if err != nil {
+				return Result{Error: err}
+			}
+			b, err := indexFuncArgSlice(args, "b")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			for _, av := range a {
+				if slices.ContainsFunc(b, func(bv any) bool { return equalValues(av, bv) }) {
+					return Result{Value: true}
+				}
+			}
+			return Result{Value: false}
+		},
+	},
+	"semver": {
+		Args: []FunctionArg{
+			{Name: "value"},
+		},
+		Eval: func(args map[string]any) Result {
+			value, err := IndexFuncArg[any](args, "value")
+			if err != nil {
+				return Result{Error: err}
+			}
+
+			v, err := ParseSemver(fmt.Sprint(value))
+			if err != nil {
+				return Result{Error: err}
+			}
+			return Result{
+				Value: v,
+			}
+		},
+	},
+	"now": {
+		Eval: func(args map[string]any) Result {
+			return Result{
+				Value: time.Now(),
+			}
+		},
+	},
+}
+
+// indexFuncArgInt returns an integer argument as an int.
+func indexFuncArgInt(args map[string]any, name string) (int, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return 0, err
+	}
+	switch v := val.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case uint:
+		return int(min(v, math.MaxInt)), nil
+	case uint64:
+		return int(min(v, math.MaxInt)), nil
+	}
+	return 0, &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "integer",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+// indexFuncArgString returns a string argument. See stringValue.
+func indexFuncArgString(args map[string]any, name string) (string, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return "", err
+	}
+	if s, ok := stringValue(val); ok {
+		return s, nil
+	}
+	return "", &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "string",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+// stringValue returns v as a string. Quoted strings that look like an IP address, CIDR or MAC address are
+// parsed as one, so these are accepted as well and converted back to their string form, e.g. "10.0.0.1".
+func stringValue(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case net.IP:
+		return v.String(), true
+	case *net.IPNet:
+		return v.String(), true
+	case net.HardwareAddr:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// indexFuncArgSlice returns the elements of an array argument of any type.
+func indexFuncArgSlice(args map[string]any, name string) ([]any, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := val.([]any); ok {
+		return s, nil
+	}
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		ret := make([]any, v.Len())
+		for i := range ret {
+			ret[i] = v.Index(i).Interface()
+		}
+		return ret, nil
+	}
+	return nil, &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "array",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+// indexFuncArgRegexp returns a regex argument. String arguments are compiled as regular expressions.
+func indexFuncArgRegexp(args map[string]any, name string) (*regexp.Regexp, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return nil, err
+	}
+	switch v := val.(type) {
+	case *regexp.Regexp:
+		return v, nil
+	case string:
+		return regexp.Compile(v)
+	}
+	return nil, &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "regex",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+// indexFuncArgIP returns an IP address argument. Strings are parsed as IP addresses, and CIDRs are
+// passed as their network address, e.g. 10.0.0.0 for 10.0.0.0/8.
+func indexFuncArgIP(args map[string]any, name string) (net.IP, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return nil, err
+	}
+	switch v := val.(type) {
+	case net.IP:
+		return v, nil
+	case *net.IPNet:
+		return v.IP.Mask(v.Mask), nil
+	case string:
+		if ip := net.ParseIP(v); ip != nil {
+			return ip, nil
+		} else if _, ipnet, err := net.ParseCIDR(v); err == nil {
+			return ipnet.IP, nil
+		}
+		return nil, fmt.Errorf("arg %s: invalid IP address %q", name, v)
+	}
+	return nil, &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "IP address",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+// indexFuncArgCIDR returns a CIDR argument. Strings are parsed as CIDRs or IP addresses, and IP addresses
+// are treated as a network of a single address, e.g. 10.0.0.1/32.
+func indexFuncArgCIDR(args map[string]any, name string) (*net.IPNet, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := val.(string); ok {
+		if _, ipnet, err := net.ParseCIDR(s); err == nil {
+			return ipnet, nil
+		} else if ip := net.ParseIP(s); ip != nil {
+			val = ip
+		} else {
+			return nil, fmt.Errorf("arg %s: invalid CIDR %q", name, s)
+		}
+	}
+	switch v := val.(type) {
+	case *net.IPNet:
+		return v, nil
+	case net.IP:
+		if ip4 := v.To4(); ip4 != nil {
+			v = ip4
+		}
+		return &net.IPNet{IP: v, Mask: net.CIDRMask(len(v)*8, len(v)*8)}, nil
+	}
+	return nil, &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "CIDR",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+// indexFuncArgIPNet returns an IP address or CIDR argument as a network, with the same errors as
+// indexFuncArgIP. An IP address is a network of a single address.
+func indexFuncArgIPNet(args map[string]any, name string) (*net.IPNet, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return nil, err
+	}
+	switch v := val.(type) {
+	case *net.IPNet:
+		return v, nil
+	case string:
+		if _, ipnet, err := net.ParseCIDR(v); err == nil {
+			return ipnet, nil
+		}
+	}
+	ip, err := indexFuncArgIP(args, name)
+	if err != nil {
+		return nil, err
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}, nil
+}
+
+// networkAll reports whether fn is true for every address in n. The private, loopback and multicast
+// ranges are themselves CIDRs, so it is enough to test the first and last addresses of n.
+func networkAll(n *net.IPNet, fn func(net.IP) bool) bool {
+	first := n.IP.Mask(n.Mask)
+	if first == nil {
+		return fn(n.IP)
+	}
+	last := make(net.IP, len(first))
+	for i := range first {
+		last[i] = first[i] | ^n.Mask[i]
+	}
+	return fn(first) && fn(last)
+}
+
+func sameIPFamily(a, b net.IP) bool {
+	return (a.To4() != nil) == (b.To4() != nil)
+}
+
+// indexFuncArgBytes returns a string, []byte or HexString argument as bytes. See stringValue.
+func indexFuncArgBytes(args map[string]any, name string) ([]byte, error) {
+	val, err := IndexFuncArg[any](args, name)
+	if err != nil {
+		return nil, err
+	}
+	switch v := val.(type) {
+	case []byte:
+		return v, nil
+	case HexString:
+		return v.Bytes, nil
+	}
+	if s, ok := stringValue(val); ok {
+		return []byte(s), nil
+	}
+	return nil, &ErrInvalidFunctionArg{
+		Name:     name,
+		Expected: "string or bytes",
+		Got:      fmt.Sprintf("%T", val),
+	}
+}
+
+var defaultPorts = map[string]int64{
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+	"ftp":   21,
+}
+
+// indexFuncArgURL returns a URL string argument parsed as a URL. If abs is set the URL must have a scheme:
+// url.Parse reads a URL without one, e.g. example.com/path, as a path, so its host would silently be empty.
+func indexFuncArgURL(args map[string]any, name string, abs bool) (*url.URL, error) {
+	str, err := indexFuncArgString(args, name)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(str)
+	if err != nil {
+		return nil, err
+	}
+	if abs && !u.IsAbs() {
+		return nil, fmt.Errorf("arg %s: %q is not an absolute URL", name, str)
+	}
+	return u, nil
+}
+
+// sumValues adds values with the same numeric promotion as arithmetic operators. An empty array sums to 0.
+func sumValues(values []any) (any, error) {
+	if len(values) == 0 {
+		return int64(0), nil
+	}
+	sum := values[0]
+	if _, ok := normalizeNumber(sum); !ok {
+		if _, ok := sum.(time.Duration); !ok {
+			return nil, fmt.Errorf("%w: cannot sum %T", ErrInvalidOperation, sum)
+		}
+	}
+	for _, v := range values[1:] {
+		var err error
+		if sum, err = arith(sum, op_ADD, v); err != nil {
+			return nil, err
+		}
+	}
+	return sum, nil
+}
+
+// extremeValue returns the smallest (want == cmpResultLess) or largest (want == cmpResultGreater) value,
+// or nil for an empty array.
+func extremeValue(values []any, want int) (any, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	ret := values[0]
+	for _, v := range values[1:] {
+		switch cmpValues(v, ret) {
+		case cmpResultNotComparable:
+			return nil, fmt.Errorf("%w: cannot compare %T and %T", ErrInvalidOperation, v, ret)
+		case want:
+			ret = v
+		}
+	}
+	return ret, nil
+}
+
+// cmpValues orders numbers, strings, IP addresses, semantic versions, durations and timestamps.
+func cmpValues(a, b any) int {
+	if a, ok := a.(string); ok {
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b)
+		}
+	}
+	return cmpRangeBound(a, b)
+}
+
+// equalValues reports whether a == b with the semantics of the == operator.
+func equalValues(a, b any) bool {
+	return compare(a, op_EQ, b)
 }
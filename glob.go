@@ -0,0 +1,85 @@
+package rulekit
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Glob is a wildcard pattern compiled to a regular expression, retaining the original pattern string.
+//
+// Path-style globs use `/` as the segment separator and host-style globs use `.`:
+//   - `*` matches any sequence of characters within a single segment
+//   - `?` matches a single character other than the separator
+//   - `**` matches any number of segments, including none: `/api/**/users` matches `/api/users`
+//     and `/api/v1/org/users`, and `**.example.com` matches `example.com` and `a.b.example.com`
+//   - a backslash escapes the following character
+//
+// Host-style globs match case-insensitively.
+type Glob struct {
+	raw_value string
+	re        *regexp.Regexp
+}
+
+func (g Glob) String() string {
+	return g.raw_value
+}
+
+// Match reports whether s matches the glob pattern.
+func (g Glob) Match(s string) bool {
+	return g.re != nil && g.re.MatchString(s)
+}
+
+// ParsePathGlob compiles a path-style glob pattern, e.g. `/api/*/users/**`.
+func ParsePathGlob(s string) (Glob, error) {
+	return parseGlob(s, '/', "")
+}
+
+// ParseHostGlob compiles a host-style glob pattern, e.g. `*.example.com`.
+func ParseHostGlob(s string) (Glob, error) {
+	return parseGlob(s, '.', "(?i)")
+}
+
+func parseGlob(s string, sep byte, flags string) (Glob, error) {
+	var (
+		quotedSep = regexp.QuoteMeta(string(sep))
+		notSep    = "[^" + quotedSep + "]"
+		b         strings.Builder
+	)
+	b.WriteString(flags + "^")
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteString(regexp.QuoteMeta(s[i : i+1]))
+		case c == sep && strings.HasPrefix(s[i+1:], "**") && i+3 == len(s):
+			// a trailing /** also matches the parent, e.g. /users/** matches /users
+			b.WriteString("(?:" + quotedSep + ".*)?")
+			i += 2
+		case c == '*' && i+1 < len(s) && s[i+1] == '*':
+			i++
+			if i+1 < len(s) && s[i+1] == sep {
+				// **/ matches zero or more segments
+				b.WriteString("(?:.*" + quotedSep + ")?")
+				i++
+			} else {
+				b.WriteString(".*")
+			}
+		case c == '*':
+			b.WriteString(notSep + "*")
+		case c == '?':
+			b.WriteString(notSep)
+		default:
+			b.WriteString(regexp.QuoteMeta(s[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return Glob{}, err
+	}
+	return Glob{
+		raw_value: s,
+		re:        re,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package rulekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlob(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		host    bool
+		match   []string
+		noMatch []string
+	}{
+		{
+			pattern: "/api/*/users",
+			match:   []string{"/api/v1/users", "/api//users"},
+			noMatch: []string{"/api/v1/org/users", "/api/v1/users/1", "/API/v1/users"},
+		},
+		{
+			pattern: "/api/**/users",
+			match:   []string{"/api/users", "/api/v1/users", "/api/v1/org/users"},
+			noMatch: []string{"/api/v1/users/1", "/apiusers"},
+		},
+		{
+			pattern: "/users/**",
+			match:   []string{"/users", "/users/", "/users/1", "/users/1/posts"},
+			noMatch: []string{"/users-old", "/user"},
+		},
+		{
+			pattern: "/static/*.js",
+			match:   []string{"/static/app.js", "/static/app.min.js"},
+			noMatch: []string{"/static/js/app.js", "/static/app.css"},
+		},
+		{
+			pattern: "/v?/items",
+			match:   []string{"/v1/items", "/v2/items"},
+			noMatch: []string{"/v10/items", "/v/items", "/v//items"},
+		},
+		{
+			pattern: `/literal\*/(x)`,
+			match:   []string{"/literal*/(x)"},
+			noMatch: []string{"/literalx/(x)", "/literal*/x"},
+		},
+		{
+			pattern: "*.example.com",
+			host:    true,
+			match:   []string{"api.example.com", "API.Example.COM"},
+			noMatch: []string{"example.com", "a.b.example.com", "api.example.com.evil.io", "apiexample.com"},
+		},
+		{
+			pattern: "**.example.com",
+			host:    true,
+			match:   []string{"example.com", "api.example.com", "a.b.example.com"},
+			noMatch: []string{"notexample.com"},
+		},
+		{
+			pattern: "api-?.*.internal",
+			host:    true,
+			match:   []string{"api-1.us-east.internal"},
+			noMatch: []string{"api-10.us-east.internal", "api-1.a.b.internal"},
+		},
+	} {
+		parse := ParsePathGlob
+		if tc.host {
+			parse = ParseHostGlob
+		}
+		g, err := parse(tc.pattern)
+		require.NoError(t, err)
+		require.Equal(t, tc.pattern, g.String())
+		for _, s := range tc.match {
+			require.Truef(t, g.Match(s), "%q should match %q", tc.pattern, s)
+		}
+		for _, s := range tc.noMatch {
+			require.Falsef(t, g.Match(s), "%q should not match %q", tc.pattern, s)
+		}
+	}
+}
@@ -9,699 +9,1438 @@ import (
 
 //line lexer.go:11
 var _ruleLexerImpl_actions []byte = []byte{
-	0, 1, 0, 1, 1, 1, 2, 1, 25,
-	1, 26, 1, 27, 1, 28, 1, 29,
-	1, 30, 1, 31, 1, 32, 1, 33,
-	1, 34, 1, 35, 1, 36, 1, 37,
-	1, 38, 1, 39, 1, 40, 1, 41,
+	0, 1, 0, 1, 1, 1, 2, 1, 41,
 	1, 42, 1, 43, 1, 44, 1, 45,
 	1, 46, 1, 47, 1, 48, 1, 49,
 	1, 50, 1, 51, 1, 52, 1, 53,
 	1, 54, 1, 55, 1, 56, 1, 57,
-	1, 58, 2, 2, 3, 2, 2, 4,
-	2, 2, 5, 2, 2, 6, 2, 2,
-	7, 2, 2, 8, 2, 2, 9, 2,
-	2, 10, 2, 2, 11, 2, 2, 12,
-	2, 2, 13, 2, 2, 14, 2, 2,
-	15, 2, 2, 16, 2, 2, 17, 2,
-	2, 18, 2, 2, 19, 2, 2, 20,
-	2, 2, 21, 2, 2, 22, 2, 2,
-	23, 2, 2, 24,
+	1, 58, 1, 59, 1, 60, 1, 61,
+	1, 62, 1, 63, 1, 64, 1, 65,
+	1, 66, 1, 67, 1, 68, 1, 69,
+	1, 70, 1, 71, 1, 72, 1, 73,
+	1, 74, 1, 75, 1, 76, 1, 77,
+	1, 78, 1, 79, 1, 80, 1, 81,
+	1, 82, 1, 83, 1, 84, 1, 85,
+	1, 86, 1, 87, 1, 88, 1, 89,
+	1, 90, 1, 91, 1, 92, 1, 93,
+	1, 94, 1, 95, 1, 96, 1, 97,
+	1, 98, 1, 99, 1, 100, 1, 101,
+	1, 102, 1, 103, 1, 104, 1, 105,
+	1, 106, 1, 107, 1, 108, 1, 109,
+	1, 110, 1, 111, 1, 112, 1, 113,
+	1, 114, 1, 115, 1, 116, 1, 117,
+	1, 118, 1, 119, 1, 120, 1, 121,
+	1, 122, 1, 123, 1, 124, 1, 125,
+	1, 126, 1, 127, 1, 128, 1, 129,
+	1, 130, 1, 131, 1, 132, 1, 133,
+	2, 2, 3, 2, 2, 4, 2, 2,
+	5, 2, 2, 6, 2, 2, 7, 2,
+	2, 8, 2, 2, 9, 2, 2, 10,
+	2, 2, 11, 2, 2, 12, 2, 2,
+	13, 2, 2, 14, 2, 2, 15, 2,
+	2, 16, 2, 2, 17, 2, 2, 18,
+	2, 2, 19, 2, 2, 20, 2, 2,
+	21, 2, 2, 22, 2, 2, 23, 2,
+	2, 24, 2, 2, 25, 2, 2, 26,
+	2, 2, 27, 2, 2, 28, 2, 2,
+	29, 2, 2, 30, 2, 2, 31, 2,
+	2, 32, 2, 2, 33, 2, 2, 34,
+	2, 2, 35, 2, 2, 36, 2, 2,
+	37, 2, 2, 38, 2, 2, 39, 2,
+	2, 40,
 }
 
 var _ruleLexerImpl_key_offsets []int16 = []int16{
-	0, 2, 4, 6, 8, 10, 12, 12,
-	15, 18, 19, 21, 22, 27, 32, 33,
-	38, 40, 43, 46, 52, 55, 62, 69,
-	76, 83, 84, 91, 98, 105, 112, 113,
-	120, 127, 134, 141, 142, 149, 156, 163,
-	170, 171, 178, 185, 192, 199, 200, 210,
-	218, 223, 224, 227, 230, 236, 239, 246,
-	253, 254, 261, 269, 277, 285, 296, 306,
-	314, 322, 329, 335, 344, 353, 362, 371,
-	372, 379, 386, 393, 400, 407, 414, 421,
-	428, 435, 442, 452, 460, 467, 473, 479,
-	487, 498, 506, 513, 520, 529, 531, 531,
-	589, 590, 592, 593, 595, 598, 600, 604,
-	605, 608, 609, 617, 620, 621, 623, 626,
-	629, 635, 638, 641, 644, 650, 655, 663,
-	671, 675, 682, 689, 696, 703, 713, 722,
-	730, 738, 740, 749, 758, 767, 779, 790,
-	799, 808, 816, 826, 835, 843, 851, 853,
-	862, 871, 880, 892, 903, 912, 921, 929,
-	939, 948, 956, 964, 966, 975, 984, 993,
-	1005, 1016, 1025, 1034, 1042, 1052, 1061, 1069,
-	1077, 1079, 1088, 1097, 1106, 1118, 1129, 1138,
-	1147, 1155, 1165, 1174, 1182, 1190, 1192, 1201,
-	1210, 1219, 1231, 1242, 1251, 1260, 1268, 1275,
-	1282, 1289, 1296, 1303, 1310, 1317, 1325, 1326,
-	1334, 1344, 1352, 1359, 1367, 1375, 1383, 1394,
-	1404, 1412, 1420, 1421, 1431, 1440, 1448, 1456,
-	1458, 1467, 1476, 1485, 1497, 1508, 1517, 1526,
-	1534, 1535, 1537, 1538, 1554, 1563, 1577, 1591,
-	1601, 1610, 1621, 1635, 1651, 1662, 1673, 1684,
-	1695, 1706, 1717, 1733, 1749, 1765, 1776, 1787,
-	1800, 1811, 1824, 1835, 1846, 1857, 1868, 1879,
-	1890, 1903, 1914, 1925, 1936, 1947,
+	0, 8, 16, 24, 32, 40, 51, 53,
+	58, 65, 67, 70, 77, 79, 80, 81,
+	82, 90, 92, 100, 102, 106, 108, 119,
+	124, 130, 132, 139, 146, 155, 162, 164,
+	166, 172, 173, 175, 181, 190, 192, 193,
+	203, 208, 216, 221, 223, 229, 231, 237,
+	244, 251, 258, 260, 265, 274, 279, 281,
+	282, 284, 290, 296, 303, 310, 319, 327,
+	328, 331, 337, 340, 347, 354, 359, 365,
+	367, 373, 380, 381, 388, 395, 400, 403,
+	409, 410, 416, 424, 431, 438, 445, 454,
+	455, 458, 464, 467, 468, 470, 476, 484,
+	485, 492, 499, 502, 509, 511, 512, 517,
+	519, 527, 534, 541, 550, 556, 557, 563,
+	569, 576, 577, 584, 591, 592, 594, 600,
+	607, 614, 623, 630, 632, 638, 646, 647,
+	654, 664, 670, 671, 677, 684, 691, 699,
+	707, 718, 726, 733, 739, 740, 742, 743,
+	750, 757, 765, 775, 783, 790, 792, 800,
+	807, 815, 816, 823, 824, 826, 828, 838,
+	842, 850, 858, 869, 877, 884, 886, 888,
+	890, 895, 902, 903, 905, 907, 913, 919,
+	1006, 1007, 1015, 1016, 1024, 1025, 1028, 1039,
+	1071, 1097, 1126, 1152, 1153, 1154, 1156, 1157,
+	1158, 1183, 1198, 1219, 1252, 1269, 1297, 1313,
+	1341, 1351, 1373, 1385, 1413, 1429, 1451, 1459,
+	1467, 1472, 1477, 1480, 1507, 1515, 1526, 1535,
+	1546, 1548, 1551, 1578, 1586, 1615, 1642, 1652,
+	1662, 1674, 1689, 1705, 1725, 1741, 1757, 1767,
+	1783, 1804, 1814, 1830, 1840, 1856, 1872, 1888,
+	1898, 1914, 1924, 1934, 1950, 1960, 1982, 1998,
+	2008, 2024, 2040, 2042, 2045, 2053, 2061, 2075,
+	2093, 2111, 2132, 2150, 2176, 2183, 2193, 2203,
+	2210, 2213, 2220, 2232, 2258, 2267, 2276, 2288,
+	2297, 2305, 2319, 2334, 2349, 2359, 2375, 2391,
+	2407, 2423, 2437, 2453, 2469, 2479, 2489, 2505,
+	2521, 2531, 2547, 2563, 2579, 2581, 2595, 2613,
+	2633, 2653, 2655, 2659, 2668, 2677, 2689, 2698,
+	2706, 2711, 2714, 2720, 2722, 2730, 2739, 2750,
+	2759, 2773, 2784, 2796, 2806, 2822, 2832, 2848,
+	2864, 2880, 2890, 2900, 2910, 2920, 2925, 2930,
+	2935, 2943, 2948, 2959, 2977, 2984, 2994, 3002,
+	3011, 3022, 3031, 3034, 3042, 3051, 3061, 3077,
+	3093, 3109, 3125, 3137, 3153, 3157, 3164, 3189,
+	3198, 3207, 3219, 3228, 3236, 3244, 3253, 3255,
+	3269, 3283, 3299, 3309, 3325, 3341, 3357, 3365,
+	3374, 3375, 3378, 3384, 3387, 3412, 3418, 3428,
+	3436, 3445, 3456, 3465, 3467, 3481, 3495, 3511,
+	3525, 3541, 3551, 3554, 3579, 3585, 3591, 3599,
+	3608, 3617, 3629, 3638, 3646, 3654, 3663, 3673,
+	3687, 3697, 3707, 3723, 3741, 3742, 3745, 3755,
+	3763, 3772, 3783, 3792, 3794, 3809, 3819, 3829,
+	3831, 3840, 3849, 3861, 3870, 3878, 3886, 3895,
+	3909, 3925, 3933, 3941, 3951, 3959, 3968, 3979,
+	3988, 3990, 4000, 4014, 4022, 4031, 4040, 4052,
+	4061, 4069, 4077, 4086, 4101, 4115, 4123, 4130,
+	4138, 4147, 4158, 4167, 4169, 4183, 4197, 4201,
+	4209, 4216, 4224, 4233, 4243, 4253, 4260, 4262,
+	4277, 4284, 4292, 4299, 4307, 4317, 4325, 4332,
+	4340,
 }
 
 var _ruleLexerImpl_trans_keys []byte = []byte{
-	34, 92, 34, 92, 39, 92, 39, 92,
-	48, 57, 47, 92, 42, 47, 92, 42,
-	47, 92, 42, 42, 47, 42, 48, 49,
-	50, 51, 57, 48, 49, 50, 51, 57,
-	46, 48, 49, 50, 51, 57, 48, 57,
-	46, 48, 57, 46, 48, 57, 46, 53,
-	48, 52, 54, 57, 46, 48, 53, 58,
-	48, 57, 65, 70, 97, 102, 58, 48,
-	57, 65, 70, 97, 102, 58, 48, 57,
+	34, 92, 0, 33, 35, 91, 93, 255,
+	34, 92, 0, 33, 35, 91, 93, 255,
+	39, 92, 0, 38, 40, 91, 93, 255,
+	39, 92, 0, 38, 40, 91, 93, 255,
+	47, 92, 0, 46, 48, 91, 93, 255,
+	42, 47, 92, 0, 41, 43, 46, 48,
+	91, 93, 255, 0, 255, 48, 49, 50,
+	51, 57, 58, 48, 57, 65, 70, 97,
+	102, 66, 105, 95, 48, 55, 95, 48,
+	57, 65, 70, 97, 102, 48, 57, 115,
+	181, 42, 92, 96, 0, 91, 93, 95,
+	97, 255, 0, 255, 92, 124, 0, 91,
+	93, 123, 125, 255, 0, 255, 43, 45,
+	48, 57, 48, 57, 42, 47, 92, 0,
+	41, 43, 46, 48, 91, 93, 255, 42,
+	0, 41, 43, 255, 48, 57, 65, 70,
+	97, 102, 48, 57, 58, 48, 57, 65,
+	70, 97, 102, 58, 48, 57, 65, 70,
+	97, 102, 43, 45, 58, 48, 57, 65,
+	70, 97, 102, 58, 48, 57, 65, 70,
+	97, 102, 48, 49, 48, 57, 48, 57,
+	65, 70, 97, 102, 66, 48, 55, 48,
+	57, 65, 70, 97, 102, 46, 104, 109,
+	110, 115, 117, 194, 48, 57, 48, 57,
+	93, 42, 45, 95, 96, 48, 57, 65,
+	90, 97, 122, 42, 0, 41, 43, 255,
+	42, 47, 0, 41, 43, 46, 48, 255,
+	48, 49, 50, 51, 57, 48, 57, 48,
+	57, 65, 70, 97, 102, 46, 58, 43,
+	45, 46, 58, 48, 57, 58, 48, 57,
 	65, 70, 97, 102, 58, 48, 57, 65,
-	70, 97, 102, 58, 58, 48, 57, 65,
 	70, 97, 102, 58, 48, 57, 65, 70,
-	97, 102, 58, 48, 57, 65, 70, 97,
-	102, 58, 48, 57, 65, 70, 97, 102,
-	58, 58, 48, 57, 65, 70, 97, 102,
+	97, 102, 48, 57, 48, 49, 50, 51,
+	57, 48, 49, 50, 51, 57, 65, 70,
+	97, 102, 13, 32, 40, 9, 10, 48,
+	57, 45, 48, 57, 48, 57, 65, 70,
+	97, 102, 48, 57, 65, 70, 97, 102,
 	58, 48, 57, 65, 70, 97, 102, 58,
-	48, 57, 65, 70, 97, 102, 58, 48,
-	57, 65, 70, 97, 102, 58, 58, 48,
+	48, 57, 65, 70, 97, 102, 48, 49,
+	50, 51, 57, 65, 70, 97, 102, 104,
+	109, 110, 115, 117, 194, 48, 57, 46,
+	46, 48, 57, 46, 53, 48, 52, 54,
+	57, 46, 48, 57, 45, 48, 57, 65,
+	90, 97, 122, 45, 48, 57, 65, 90,
+	97, 122, 48, 49, 50, 51, 57, 48,
+	57, 65, 70, 97, 102, 48, 57, 48,
 	57, 65, 70, 97, 102, 58, 48, 57,
+	65, 70, 97, 102, 58, 58, 48, 57,
 	65, 70, 97, 102, 58, 48, 57, 65,
-	70, 97, 102, 58, 48, 57, 65, 70,
-	97, 102, 58, 58, 48, 57, 65, 70,
-	97, 102, 58, 48, 57, 65, 70, 97,
-	102, 58, 48, 57, 65, 70, 97, 102,
+	70, 97, 102, 48, 49, 50, 51, 57,
+	46, 48, 53, 48, 57, 65, 70, 97,
+	102, 45, 48, 57, 65, 70, 97, 102,
+	43, 45, 48, 57, 65, 70, 97, 102,
 	58, 48, 57, 65, 70, 97, 102, 58,
-	48, 49, 50, 58, 51, 57, 65, 70,
-	97, 102, 46, 58, 48, 57, 65, 70,
-	97, 102, 48, 49, 50, 51, 57, 46,
-	46, 48, 57, 46, 48, 57, 46, 53,
-	48, 52, 54, 57, 46, 48, 53, 58,
 	48, 57, 65, 70, 97, 102, 58, 48,
-	57, 65, 70, 97, 102, 58, 58, 48,
-	57, 65, 70, 97, 102, 46, 58, 48,
-	57, 65, 70, 97, 102, 46, 58, 48,
-	57, 65, 70, 97, 102, 46, 58, 48,
-	57, 65, 70, 97, 102, 46, 53, 58,
-	48, 52, 54, 57, 65, 70, 97, 102,
-	46, 58, 48, 53, 54, 57, 65, 70,
-	97, 102, 46, 58, 48, 57, 65, 70,
-	97, 102, 46, 58, 48, 57, 65, 70,
-	97, 102, 58, 48, 57, 65, 70, 97,
-	102, 48, 57, 65, 70, 97, 102, 48,
-	49, 50, 51, 57, 65, 70, 97, 102,
-	48, 49, 50, 51, 57, 65, 70, 97,
-	102, 48, 49, 50, 51, 57, 65, 70,
-	97, 102, 48, 49, 50, 51, 57, 65,
-	70, 97, 102, 58, 58, 48, 57, 65,
-	70, 97, 102, 58, 48, 57, 65, 70,
-	97, 102, 58, 48, 57, 65, 70, 97,
+	57, 65, 70, 97, 102, 48, 49, 50,
+	51, 57, 65, 70, 97, 102, 46, 46,
+	48, 57, 46, 53, 48, 52, 54, 57,
+	46, 48, 57, 45, 48, 57, 48, 57,
+	65, 70, 97, 102, 43, 45, 48, 57,
+	65, 70, 97, 102, 58, 58, 48, 57,
+	65, 70, 97, 102, 58, 48, 57, 65,
+	70, 97, 102, 46, 48, 53, 42, 48,
+	57, 65, 70, 97, 102, 48, 57, 46,
+	43, 45, 46, 48, 57, 48, 57, 42,
+	58, 48, 57, 65, 70, 97, 102, 58,
+	48, 57, 65, 70, 97, 102, 58, 48,
+	57, 65, 70, 97, 102, 48, 49, 50,
+	51, 57, 65, 70, 97, 102, 48, 57,
+	65, 70, 97, 102, 84, 48, 57, 65,
+	70, 97, 102, 48, 57, 65, 70, 97,
 	102, 58, 48, 57, 65, 70, 97, 102,
+	58, 58, 48, 57, 65, 70, 97, 102,
+	58, 48, 57, 65, 70, 97, 102, 45,
+	48, 57, 48, 57, 65, 70, 97, 102,
 	58, 48, 57, 65, 70, 97, 102, 58,
+	48, 57, 65, 70, 97, 102, 48, 49,
+	50, 51, 57, 65, 70, 97, 102, 42,
+	48, 57, 65, 70, 97, 102, 48, 57,
+	48, 57, 65, 70, 97, 102, 42, 58,
+	48, 57, 65, 70, 97, 102, 58, 58,
+	48, 57, 65, 70, 97, 102, 48, 49,
+	50, 58, 51, 57, 65, 70, 97, 102,
 	48, 57, 65, 70, 97, 102, 58, 48,
 	57, 65, 70, 97, 102, 58, 48, 57,
 	65, 70, 97, 102, 58, 48, 57, 65,
-	70, 97, 102, 58, 48, 57, 65, 70,
-	97, 102, 48, 49, 50, 58, 51, 57,
-	65, 70, 97, 102, 46, 58, 48, 57,
-	65, 70, 97, 102, 58, 48, 57, 65,
-	70, 97, 102, 48, 57, 65, 70, 97,
-	102, 48, 57, 65, 70, 97, 102, 46,
+	70, 97, 102, 46, 58, 48, 57, 65,
+	70, 97, 102, 46, 58, 48, 57, 65,
+	70, 97, 102, 46, 53, 58, 48, 52,
+	54, 57, 65, 70, 97, 102, 46, 58,
+	48, 57, 65, 70, 97, 102, 58, 48,
+	57, 65, 70, 97, 102, 48, 57, 65,
+	70, 97, 102, 45, 48, 57, 58, 58,
+	48, 57, 65, 70, 97, 102, 58, 48,
+	57, 65, 70, 97, 102, 46, 58, 48,
+	57, 65, 70, 97, 102, 46, 58, 48,
+	53, 54, 57, 65, 70, 97, 102, 46,
+	58, 48, 57, 65, 70, 97, 102, 42,
+	48, 57, 65, 70, 97, 102, 48, 57,
+	42, 58, 48, 57, 65, 70, 97, 102,
 	58, 48, 57, 65, 70, 97, 102, 46,
-	53, 58, 48, 52, 54, 57, 65, 70,
+	58, 48, 57, 65, 70, 97, 102, 58,
+	58, 48, 57, 65, 70, 97, 102, 58,
+	48, 57, 48, 57, 48, 49, 50, 58,
+	51, 57, 65, 70, 97, 102, 43, 45,
+	46, 90, 46, 58, 48, 57, 65, 70,
 	97, 102, 46, 58, 48, 57, 65, 70,
-	97, 102, 58, 48, 57, 65, 70, 97,
-	102, 58, 48, 57, 65, 70, 97, 102,
-	48, 49, 50, 51, 57, 65, 70, 97,
-	102, 92, 124, 13, 32, 33, 34, 38,
-	39, 40, 41, 43, 44, 45, 46, 47,
-	48, 49, 50, 58, 60, 61, 62, 65,
-	67, 69, 70, 71, 73, 76, 77, 78,
-	79, 84, 91, 93, 95, 97, 99, 101,
-	102, 103, 105, 108, 109, 110, 111, 116,
-	124, 9, 10, 51, 57, 66, 68, 72,
-	90, 98, 100, 104, 122, 61, 34, 92,
-	38, 39, 92, 46, 48, 57, 48, 57,
-	45, 46, 48, 57, 10, 42, 47, 92,
-	42, 46, 58, 48, 57, 65, 70, 97,
-	102, 46, 48, 57, 47, 48, 57, 47,
-	48, 57, 47, 48, 57, 47, 53, 48,
-	52, 54, 57, 47, 48, 53, 46, 48,
-	57, 46, 48, 57, 46, 53, 48, 52,
-	54, 57, 46, 48, 53, 54, 57, 46,
-	58, 48, 57, 65, 70, 97, 102, 46,
-	58, 48, 57, 65, 70, 97, 102, 46,
-	58, 48, 57, 47, 48, 57, 65, 70,
-	97, 102, 47, 48, 57, 65, 70, 97,
-	102, 47, 48, 57, 65, 70, 97, 102,
-	47, 48, 57, 65, 70, 97, 102, 47,
+	97, 102, 46, 53, 58, 48, 52, 54,
+	57, 65, 70, 97, 102, 46, 58, 48,
+	57, 65, 70, 97, 102, 58, 48, 57,
+	65, 70, 97, 102, 48, 57, 48, 57,
+	48, 57, 43, 45, 90, 48, 57, 58,
+	48, 57, 65, 70, 97, 102, 58, 48,
+	57, 48, 57, 48, 57, 65, 70, 97,
+	102, 48, 57, 65, 70, 97, 102, 13,
+	32, 33, 34, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49,
+	50, 58, 59, 60, 61, 62, 63, 64,
+	65, 66, 67, 68, 69, 70, 71, 72,
+	73, 76, 77, 78, 79, 84, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 108, 109, 110,
+	111, 116, 123, 124, 0, 8, 9, 10,
+	11, 12, 14, 31, 35, 36, 51, 57,
+	74, 75, 80, 83, 85, 90, 106, 107,
+	112, 115, 117, 122, 125, 255, 61, 34,
+	92, 0, 33, 35, 91, 93, 255, 38,
+	39, 92, 0, 38, 40, 91, 93, 255,
+	45, 46, 48, 57, 42, 47, 92, 0,
+	41, 43, 46, 48, 91, 93, 255, 46,
+	58, 65, 66, 69, 70, 71, 75, 77,
+	79, 80, 84, 88, 95, 97, 98, 101,
+	102, 104, 109, 110, 111, 115, 117, 120,
+	194, 48, 57, 67, 68, 99, 100, 46,
+	58, 65, 66, 69, 70, 71, 75, 77,
+	80, 84, 95, 101, 102, 104, 109, 110,
+	115, 117, 194, 48, 57, 67, 68, 97,
+	100, 46, 53, 58, 65, 66, 69, 70,
+	71, 75, 77, 80, 84, 95, 101, 102,
+	104, 109, 110, 115, 117, 194, 48, 52,
+	54, 57, 67, 68, 97, 100, 46, 58,
+	65, 66, 69, 70, 71, 75, 77, 80,
+	84, 95, 101, 102, 104, 109, 110, 115,
+	117, 194, 48, 57, 67, 68, 97, 100,
+	58, 61, 61, 126, 61, 63, 45, 46,
+	58, 76, 77, 78, 91, 95, 108, 109,
+	110, 48, 57, 65, 70, 71, 75, 79,
+	90, 97, 102, 103, 107, 111, 122, 45,
+	46, 58, 91, 95, 48, 57, 65, 70,
+	71, 90, 97, 102, 103, 122, 45, 46,
+	58, 79, 91, 95, 111, 48, 57, 65,
+	70, 71, 78, 80, 90, 97, 102, 103,
+	110, 112, 122, 45, 46, 58, 76, 81,
+	88, 91, 95, 108, 113, 120, 48, 57,
+	65, 70, 71, 75, 77, 80, 82, 87,
+	89, 90, 97, 102, 103, 107, 109, 112,
+	114, 119, 121, 122, 45, 46, 58, 65,
+	91, 95, 97, 48, 57, 66, 70, 71,
+	90, 98, 102, 103, 122, 45, 46, 69,
+	76, 84, 91, 95, 101, 108, 116, 48,
+	57, 65, 68, 70, 75, 77, 83, 85,
+	90, 97, 100, 102, 107, 109, 115, 117,
+	122, 45, 46, 79, 91, 95, 111, 48,
+	57, 65, 78, 80, 90, 97, 110, 112,
+	122, 45, 46, 67, 68, 69, 70, 78,
+	91, 95, 99, 100, 101, 102, 110, 48,
+	57, 65, 66, 71, 77, 79, 90, 97,
+	98, 103, 109, 111, 122, 45, 46, 91,
+	95, 48, 57, 65, 90, 97, 122, 45,
+	46, 69, 84, 91, 95, 101, 116, 48,
+	57, 65, 68, 70, 83, 85, 90, 97,
+	100, 102, 115, 117, 122, 45, 46, 65,
+	91, 95, 97, 48, 57, 66, 90, 98,
+	122, 45, 46, 69, 79, 85, 91, 95,
+	101, 111, 117, 48, 57, 65, 68, 70,
+	78, 80, 84, 86, 90, 97, 100, 102,
+	110, 112, 116, 118, 122, 45, 46, 82,
+	91, 95, 114, 48, 57, 65, 81, 83,
+	90, 97, 113, 115, 122, 45, 46, 72,
+	82, 91, 95, 104, 114, 48, 57, 65,
+	71, 73, 81, 83, 90, 97, 103, 105,
+	113, 115, 122, 92, 96, 0, 91, 93,
+	95, 97, 255, 92, 124, 0, 91, 93,
+	123, 125, 255, 10, 0, 9, 11, 255,
+	69, 95, 101, 48, 57, 105, 109, 115,
+	45, 46, 58, 65, 66, 69, 70, 71,
+	75, 77, 80, 84, 95, 101, 102, 104,
+	109, 110, 115, 117, 194, 48, 57, 67,
+	68, 97, 100, 45, 58, 48, 57, 65,
+	70, 97, 102, 45, 58, 95, 48, 49,
+	50, 57, 65, 70, 97, 102, 43, 45,
+	58, 48, 57, 65, 70, 97, 102, 45,
+	58, 95, 48, 49, 50, 57, 65, 70,
+	97, 102, 48, 57, 115, 48, 57, 45,
+	46, 58, 65, 66, 69, 70, 71, 75,
+	77, 80, 84, 95, 101, 102, 104, 109,
+	110, 115, 117, 194, 48, 57, 67, 68,
+	97, 100, 45, 58, 48, 57, 65, 70,
+	97, 102, 45, 46, 58, 65, 66, 69,
+	70, 71, 75, 77, 80, 84, 95, 101,
+	102, 104, 109, 110, 115, 117, 194, 48,
+	53, 54, 57, 67, 68, 97, 100, 45,
+	46, 58, 65, 66, 69, 70, 71, 75,
+	77, 80, 84, 95, 101, 102, 104, 109,
+	110, 115, 117, 194, 48, 57, 67, 68,
+	97, 100, 47, 48, 49, 50, 51, 57,
+	65, 70, 97, 102, 45, 46, 91, 95,
+	48, 57, 65, 90, 97, 122, 42, 45,
+	46, 91, 95, 96, 48, 57, 65, 90,
+	97, 122, 45, 46, 58, 91, 95, 48,
+	57, 65, 70, 71, 90, 97, 102, 103,
+	122, 45, 46, 76, 91, 95, 108, 48,
+	57, 65, 75, 77, 90, 97, 107, 109,
+	122, 45, 46, 68, 89, 90, 91, 95,
+	100, 121, 122, 48, 57, 65, 67, 69,
+	88, 97, 99, 101, 120, 45, 46, 78,
+	91, 95, 110, 48, 57, 65, 77, 79,
+	90, 97, 109, 111, 122, 45, 46, 83,
+	91, 95, 115, 48, 57, 65, 82, 84,
+	90, 97, 114, 116, 122, 45, 46, 91,
+	95, 48, 57, 65, 90, 97, 122, 45,
+	46, 73, 91, 95, 105, 48, 57, 65,
+	72, 74, 90, 97, 104, 106, 122, 45,
+	46, 58, 76, 91, 95, 108, 48, 57,
+	65, 70, 71, 75, 77, 90, 97, 102,
+	103, 107, 109, 122, 45, 46, 91, 95,
+	48, 57, 65, 90, 97, 122, 45, 46,
+	79, 91, 95, 111, 48, 57, 65, 78,
+	80, 90, 97, 110, 112, 122, 45, 46,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	45, 46, 83, 91, 95, 115, 48, 57,
+	65, 82, 84, 90, 97, 114, 116, 122,
+	45, 46, 79, 91, 95, 111, 48, 57,
+	65, 78, 80, 90, 97, 110, 112, 122,
+	45, 46, 81, 91, 95, 113, 48, 57,
+	65, 80, 82, 90, 97, 112, 114, 122,
+	45, 46, 91, 95, 48, 57, 65, 90,
+	97, 122, 45, 46, 69, 91, 95, 101,
+	48, 57, 65, 68, 70, 90, 97, 100,
+	102, 122, 45, 46, 91, 95, 48, 57,
+	65, 90, 97, 122, 45, 46, 91, 95,
+	48, 57, 65, 90, 97, 122, 45, 46,
+	84, 91, 95, 116, 48, 57, 65, 83,
+	85, 90, 97, 115, 117, 122, 45, 46,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	45, 46, 78, 84, 91, 95, 110, 116,
+	48, 57, 65, 77, 79, 83, 85, 90,
+	97, 109, 111, 115, 117, 122, 45, 46,
+	76, 91, 95, 108, 48, 57, 65, 75,
+	77, 90, 97, 107, 109, 122, 45, 46,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	45, 46, 69, 91, 95, 101, 48, 57,
+	65, 68, 70, 90, 97, 100, 102, 122,
+	45, 46, 85, 91, 95, 117, 48, 57,
+	65, 84, 86, 90, 97, 116, 118, 122,
+	46, 91, 105, 109, 115, 34, 92, 0,
+	33, 35, 91, 93, 255, 39, 92, 0,
+	38, 40, 91, 93, 255, 42, 105, 109,
+	115, 0, 41, 43, 104, 106, 108, 110,
+	114, 116, 255, 46, 66, 69, 71, 75,
+	77, 80, 84, 95, 101, 104, 109, 110,
+	115, 117, 194, 48, 57, 46, 66, 69,
+	71, 75, 77, 80, 84, 95, 101, 104,
+	109, 110, 115, 117, 194, 48, 57, 46,
+	53, 66, 69, 71, 75, 77, 80, 84,
+	95, 101, 104, 109, 110, 115, 117, 194,
+	48, 52, 54, 57, 46, 66, 69, 71,
+	75, 77, 80, 84, 95, 101, 104, 109,
+	110, 115, 117, 194, 48, 57, 46, 58,
+	65, 66, 69, 70, 71, 75, 77, 80,
+	84, 95, 101, 102, 104, 109, 110, 115,
+	117, 194, 48, 57, 67, 68, 97, 100,
+	58, 48, 57, 65, 70, 97, 102, 47,
 	48, 49, 50, 51, 57, 65, 70, 97,
+	102, 58, 95, 48, 49, 50, 57, 65,
+	70, 97, 102, 58, 48, 57, 65, 70,
+	97, 102, 95, 48, 55, 95, 48, 57,
+	65, 70, 97, 102, 46, 66, 69, 71,
+	75, 77, 80, 84, 95, 101, 48, 57,
+	46, 58, 65, 66, 69, 70, 71, 75,
+	77, 80, 84, 95, 101, 102, 104, 109,
+	110, 115, 117, 194, 48, 57, 67, 68,
+	97, 100, 46, 47, 58, 48, 57, 65,
+	70, 97, 102, 46, 47, 58, 48, 57,
+	65, 70, 97, 102, 46, 47, 53, 58,
+	48, 52, 54, 57, 65, 70, 97, 102,
+	46, 47, 58, 48, 57, 65, 70, 97,
+	102, 47, 58, 48, 57, 65, 70, 97,
+	102, 45, 46, 91, 95, 48, 57, 65,
+	70, 71, 90, 97, 102, 103, 122, 45,
+	46, 58, 91, 95, 48, 57, 65, 70,
+	71, 90, 97, 102, 103, 122, 13, 32,
+	40, 45, 46, 91, 95, 9, 10, 48,
+	57, 65, 90, 97, 122, 45, 46, 91,
+	95, 48, 57, 65, 90, 97, 122, 45,
+	46, 84, 91, 95, 116, 48, 57, 65,
+	83, 85, 90, 97, 115, 117, 122, 45,
+	46, 69, 91, 95, 101, 48, 57, 65,
+	68, 70, 90, 97, 100, 102, 122, 45,
+	46, 83, 91, 95, 115, 48, 57, 65,
+	82, 84, 90, 97, 114, 116, 122, 45,
+	46, 83, 91, 95, 115, 48, 57, 65,
+	82, 84, 90, 97, 114, 116, 122, 45,
+	46, 65, 66, 91, 95, 97, 98, 48,
+	57, 67, 90, 99, 122, 45, 46, 84,
+	91, 95, 116, 48, 57, 65, 83, 85,
+	90, 97, 115, 117, 122, 45, 46, 78,
+	91, 95, 110, 48, 57, 65, 77, 79,
+	90, 97, 109, 111, 122, 45, 46, 91,
+	95, 48, 57, 65, 90, 97, 122, 45,
+	46, 91, 95, 48, 57, 65, 90, 97,
+	122, 45, 46, 67, 91, 95, 99, 48,
+	57, 65, 66, 68, 90, 97, 98, 100,
+	122, 45, 46, 69, 91, 95, 101, 48,
+	57, 65, 68, 70, 90, 97, 100, 102,
+	122, 45, 46, 91, 95, 48, 57, 65,
+	90, 97, 122, 45, 46, 76, 91, 95,
+	108, 48, 57, 65, 75, 77, 90, 97,
+	107, 109, 122, 45, 46, 78, 91, 95,
+	110, 48, 57, 65, 77, 79, 90, 97,
+	109, 111, 122, 45, 46, 69, 91, 95,
+	101, 48, 57, 65, 68, 70, 90, 97,
+	100, 102, 122, 48, 57, 42, 105, 109,
+	115, 0, 41, 43, 104, 106, 108, 110,
+	114, 116, 255, 46, 66, 69, 71, 75,
+	77, 80, 84, 95, 101, 104, 109, 110,
+	115, 117, 194, 48, 57, 46, 66, 69,
+	71, 75, 77, 80, 84, 95, 101, 104,
+	109, 110, 115, 117, 194, 48, 53, 54,
+	57, 45, 46, 58, 66, 69, 71, 75,
+	77, 80, 84, 95, 101, 104, 109, 110,
+	115, 117, 194, 48, 57, 46, 58, 46,
+	58, 48, 57, 46, 47, 58, 48, 57,
+	65, 70, 97, 102, 46, 47, 58, 48,
+	57, 65, 70, 97, 102, 46, 47, 53,
+	58, 48, 52, 54, 57, 65, 70, 97,
 	102, 46, 47, 58, 48, 57, 65, 70,
 	97, 102, 47, 58, 48, 57, 65, 70,
-	97, 102, 47, 58, 48, 57, 65, 70,
-	97, 102, 47, 58, 46, 47, 58, 48,
-	57, 65, 70, 97, 102, 46, 47, 58,
-	48, 57, 65, 70, 97, 102, 46, 47,
-	58, 48, 57, 65, 70, 97, 102, 46,
-	47, 53, 58, 48, 52, 54, 57, 65,
+	97, 102, 46, 58, 95, 48, 49, 95,
+	48, 49, 48, 57, 65, 70, 97, 102,
+	48, 57, 47, 58, 48, 57, 65, 70,
+	97, 102, 46, 47, 58, 48, 57, 65,
 	70, 97, 102, 46, 47, 58, 48, 53,
 	54, 57, 65, 70, 97, 102, 46, 47,
-	58, 48, 57, 65, 70, 97, 102, 46,
-	47, 58, 48, 57, 65, 70, 97, 102,
-	47, 58, 48, 57, 65, 70, 97, 102,
+	58, 48, 57, 65, 70, 97, 102, 45,
+	46, 91, 95, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 45, 46, 58,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	45, 46, 65, 91, 95, 97, 48, 57,
+	66, 90, 98, 122, 45, 46, 91, 95,
+	48, 57, 65, 90, 97, 122, 45, 46,
+	84, 91, 95, 116, 48, 57, 65, 83,
+	85, 90, 97, 115, 117, 122, 45, 46,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	45, 46, 71, 91, 95, 103, 48, 57,
+	65, 70, 72, 90, 97, 102, 104, 122,
+	45, 46, 84, 91, 95, 116, 48, 57,
+	65, 83, 85, 90, 97, 115, 117, 122,
+	45, 46, 72, 91, 95, 104, 48, 57,
+	65, 71, 73, 90, 97, 103, 105, 122,
+	45, 46, 91, 95, 48, 57, 65, 90,
+	97, 122, 45, 46, 91, 95, 48, 57,
+	65, 90, 97, 122, 45, 46, 91, 95,
+	48, 57, 65, 90, 97, 122, 45, 46,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	42, 0, 41, 43, 255, 43, 45, 46,
+	48, 57, 43, 45, 46, 48, 57, 43,
+	45, 46, 53, 48, 52, 54, 57, 43,
+	45, 46, 48, 57, 66, 69, 71, 75,
+	77, 80, 84, 95, 101, 48, 57, 46,
+	66, 69, 71, 75, 77, 80, 84, 95,
+	101, 104, 109, 110, 115, 117, 194, 48,
+	57, 58, 48, 57, 65, 70, 97, 102,
 	47, 48, 49, 50, 51, 57, 65, 70,
+	97, 102, 47, 58, 48, 57, 65, 70,
 	97, 102, 46, 47, 58, 48, 57, 65,
-	70, 97, 102, 47, 58, 48, 57, 65,
-	70, 97, 102, 47, 58, 48, 57, 65,
-	70, 97, 102, 47, 58, 46, 47, 58,
+	70, 97, 102, 46, 47, 58, 48, 53,
+	54, 57, 65, 70, 97, 102, 46, 47,
+	58, 48, 57, 65, 70, 97, 102, 45,
+	48, 57, 47, 58, 48, 57, 65, 70,
+	97, 102, 46, 47, 58, 48, 57, 65,
+	70, 97, 102, 45, 46, 91, 95, 48,
+	57, 65, 90, 97, 122, 42, 45, 46,
+	91, 95, 96, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 45, 46, 73,
+	91, 95, 105, 48, 57, 65, 72, 74,
+	90, 97, 104, 106, 122, 45, 46, 83,
+	91, 95, 115, 48, 57, 65, 82, 84,
+	90, 97, 114, 116, 122, 45, 46, 76,
+	91, 95, 108, 48, 57, 65, 75, 77,
+	90, 97, 107, 109, 122, 45, 46, 65,
+	91, 95, 97, 48, 57, 66, 90, 98,
+	122, 45, 46, 69, 91, 95, 101, 48,
+	57, 65, 68, 70, 90, 97, 100, 102,
+	122, 43, 45, 48, 57, 43, 45, 46,
+	48, 53, 54, 57, 46, 65, 66, 69,
+	70, 71, 75, 77, 80, 84, 95, 101,
+	102, 104, 109, 110, 115, 117, 194, 48,
+	57, 67, 68, 97, 100, 46, 47, 58,
 	48, 57, 65, 70, 97, 102, 46, 47,
 	58, 48, 57, 65, 70, 97, 102, 46,
-	47, 58, 48, 57, 65, 70, 97, 102,
-	46, 47, 53, 58, 48, 52, 54, 57,
-	65, 70, 97, 102, 46, 47, 58, 48,
-	53, 54, 57, 65, 70, 97, 102, 46,
-	47, 58, 48, 57, 65, 70, 97, 102,
-	46, 47, 58, 48, 57, 65, 70, 97,
-	102, 47, 58, 48, 57, 65, 70, 97,
-	102, 47, 48, 49, 50, 51, 57, 65,
+	47, 53, 58, 48, 52, 54, 57, 65,
 	70, 97, 102, 46, 47, 58, 48, 57,
 	65, 70, 97, 102, 47, 58, 48, 57,
 	65, 70, 97, 102, 47, 58, 48, 57,
-	65, 70, 97, 102, 47, 58, 46, 47,
+	65, 70, 97, 102, 46, 47, 58, 48,
+	57, 65, 70, 97, 102, 47, 58, 45,
+	46, 91, 95, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 45, 46, 91,
+	95, 48, 57, 65, 70, 71, 90, 97,
+	102, 103, 122, 45, 46, 78, 91, 95,
+	110, 48, 57, 65, 77, 79, 90, 97,
+	109, 111, 122, 45, 46, 91, 95, 48,
+	57, 65, 90, 97, 122, 45, 46, 79,
+	91, 95, 111, 48, 57, 65, 78, 80,
+	90, 97, 110, 112, 122, 45, 46, 73,
+	91, 95, 105, 48, 57, 65, 72, 74,
+	90, 97, 104, 106, 122, 45, 46, 83,
+	91, 95, 115, 48, 57, 65, 82, 84,
+	90, 97, 114, 116, 122, 45, 46, 48,
+	57, 65, 90, 97, 122, 43, 45, 46,
+	48, 57, 65, 90, 97, 122, 47, 47,
+	48, 57, 47, 53, 48, 52, 54, 57,
+	47, 48, 57, 46, 65, 66, 69, 70,
+	71, 75, 77, 80, 84, 95, 101, 102,
+	104, 109, 110, 115, 117, 194, 48, 57,
+	67, 68, 97, 100, 48, 57, 65, 70,
+	97, 102, 47, 48, 49, 50, 51, 57,
+	65, 70, 97, 102, 47, 58, 48, 57,
+	65, 70, 97, 102, 46, 47, 58, 48,
+	57, 65, 70, 97, 102, 46, 47, 58,
+	48, 53, 54, 57, 65, 70, 97, 102,
+	46, 47, 58, 48, 57, 65, 70, 97,
+	102, 47, 58, 45, 46, 91, 95, 48,
+	57, 65, 70, 71, 90, 97, 102, 103,
+	122, 45, 46, 91, 95, 48, 57, 65,
+	70, 71, 90, 97, 102, 103, 122, 45,
+	46, 83, 91, 95, 115, 48, 57, 65,
+	82, 84, 90, 97, 114, 116, 122, 45,
+	46, 65, 66, 91, 95, 97, 98, 48,
+	57, 67, 90, 99, 122, 45, 46, 78,
+	91, 95, 110, 48, 57, 65, 77, 79,
+	90, 97, 109, 111, 122, 45, 46, 91,
+	95, 48, 57, 65, 90, 97, 122, 47,
+	48, 53, 46, 65, 66, 69, 70, 71,
+	75, 77, 80, 84, 95, 101, 102, 104,
+	109, 110, 115, 117, 194, 48, 57, 67,
+	68, 97, 100, 48, 57, 65, 70, 97,
+	102, 48, 57, 65, 70, 97, 102, 47,
 	58, 48, 57, 65, 70, 97, 102, 46,
 	47, 58, 48, 57, 65, 70, 97, 102,
 	46, 47, 58, 48, 57, 65, 70, 97,
 	102, 46, 47, 53, 58, 48, 52, 54,
 	57, 65, 70, 97, 102, 46, 47, 58,
-	48, 53, 54, 57, 65, 70, 97, 102,
-	46, 47, 58, 48, 57, 65, 70, 97,
-	102, 46, 47, 58, 48, 57, 65, 70,
-	97, 102, 47, 58, 48, 57, 65, 70,
-	97, 102, 47, 48, 49, 50, 51, 57,
+	48, 57, 65, 70, 97, 102, 47, 58,
+	48, 57, 65, 70, 97, 102, 47, 58,
+	48, 57, 65, 70, 97, 102, 46, 47,
+	58, 48, 57, 65, 70, 97, 102, 45,
+	46, 91, 95, 48, 57, 65, 90, 97,
+	122, 45, 46, 91, 95, 48, 57, 65,
+	70, 71, 90, 97, 102, 103, 122, 45,
+	46, 91, 95, 48, 57, 65, 90, 97,
+	122, 45, 46, 91, 95, 48, 57, 65,
+	90, 97, 122, 45, 46, 83, 91, 95,
+	115, 48, 57, 65, 82, 84, 90, 97,
+	114, 116, 122, 46, 66, 69, 71, 75,
+	77, 80, 84, 95, 101, 104, 109, 110,
+	115, 117, 194, 48, 57, 46, 46, 48,
+	57, 47, 48, 49, 50, 51, 57, 65,
+	70, 97, 102, 47, 58, 48, 57, 65,
+	70, 97, 102, 46, 47, 58, 48, 57,
 	65, 70, 97, 102, 46, 47, 58, 48,
-	57, 65, 70, 97, 102, 47, 58, 48,
-	57, 65, 70, 97, 102, 47, 58, 48,
-	57, 65, 70, 97, 102, 47, 58, 46,
+	53, 54, 57, 65, 70, 97, 102, 46,
+	47, 58, 48, 57, 65, 70, 97, 102,
+	47, 58, 42, 45, 46, 91, 95, 48,
+	57, 65, 70, 71, 90, 97, 102, 103,
+	122, 45, 46, 91, 95, 48, 57, 65,
+	90, 97, 122, 45, 46, 91, 95, 48,
+	57, 65, 90, 97, 122, 48, 57, 46,
 	47, 58, 48, 57, 65, 70, 97, 102,
 	46, 47, 58, 48, 57, 65, 70, 97,
-	102, 46, 47, 58, 48, 57, 65, 70,
-	97, 102, 46, 47, 53, 58, 48, 52,
-	54, 57, 65, 70, 97, 102, 46, 47,
-	58, 48, 53, 54, 57, 65, 70, 97,
-	102, 46, 47, 58, 48, 57, 65, 70,
-	97, 102, 46, 47, 58, 48, 57, 65,
-	70, 97, 102, 47, 58, 48, 57, 65,
-	70, 97, 102, 47, 48, 49, 50, 51,
+	102, 46, 47, 53, 58, 48, 52, 54,
 	57, 65, 70, 97, 102, 46, 47, 58,
 	48, 57, 65, 70, 97, 102, 47, 58,
 	48, 57, 65, 70, 97, 102, 47, 58,
-	48, 57, 65, 70, 97, 102, 47, 58,
-	46, 47, 58, 48, 57, 65, 70, 97,
-	102, 46, 47, 58, 48, 57, 65, 70,
-	97, 102, 46, 47, 58, 48, 57, 65,
-	70, 97, 102, 46, 47, 53, 58, 48,
-	52, 54, 57, 65, 70, 97, 102, 46,
-	47, 58, 48, 53, 54, 57, 65, 70,
-	97, 102, 46, 47, 58, 48, 57, 65,
-	70, 97, 102, 46, 47, 58, 48, 57,
-	65, 70, 97, 102, 47, 58, 48, 57,
-	65, 70, 97, 102, 58, 48, 57, 65,
-	70, 97, 102, 58, 48, 57, 65, 70,
-	97, 102, 58, 48, 57, 65, 70, 97,
-	102, 58, 48, 57, 65, 70, 97, 102,
-	58, 48, 57, 65, 70, 97, 102, 58,
-	48, 57, 65, 70, 97, 102, 47, 48,
+	48, 57, 65, 70, 97, 102, 46, 47,
+	58, 48, 57, 65, 70, 97, 102, 45,
+	46, 91, 95, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 42, 45, 46,
+	91, 95, 96, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 43, 45, 48,
 	57, 65, 70, 97, 102, 47, 58, 48,
-	57, 65, 70, 97, 102, 58, 46, 58,
-	48, 57, 65, 70, 97, 102, 46, 58,
-	48, 53, 54, 57, 65, 70, 97, 102,
-	46, 58, 48, 57, 65, 70, 97, 102,
-	58, 48, 57, 65, 70, 97, 102, 46,
+	57, 65, 70, 97, 102, 47, 48, 49,
+	50, 51, 57, 65, 70, 97, 102, 47,
 	58, 48, 57, 65, 70, 97, 102, 46,
-	58, 48, 57, 65, 70, 97, 102, 46,
-	58, 48, 57, 65, 70, 97, 102, 46,
-	53, 58, 48, 52, 54, 57, 65, 70,
-	97, 102, 46, 58, 48, 53, 54, 57,
-	65, 70, 97, 102, 46, 58, 48, 57,
-	65, 70, 97, 102, 46, 58, 48, 57,
-	65, 70, 97, 102, 58, 47, 48, 49,
-	50, 51, 57, 65, 70, 97, 102, 46,
-	47, 58, 48, 57, 65, 70, 97, 102,
-	47, 58, 48, 57, 65, 70, 97, 102,
 	47, 58, 48, 57, 65, 70, 97, 102,
-	47, 58, 46, 47, 58, 48, 57, 65,
+	46, 47, 58, 48, 53, 54, 57, 65,
 	70, 97, 102, 46, 47, 58, 48, 57,
+	65, 70, 97, 102, 47, 58, 45, 46,
+	91, 95, 48, 57, 65, 90, 97, 122,
+	45, 46, 91, 95, 48, 57, 65, 70,
+	71, 90, 97, 102, 103, 122, 43, 45,
+	48, 57, 65, 70, 97, 102, 46, 47,
+	58, 48, 57, 65, 70, 97, 102, 46,
+	47, 58, 48, 57, 65, 70, 97, 102,
+	46, 47, 53, 58, 48, 52, 54, 57,
 	65, 70, 97, 102, 46, 47, 58, 48,
-	57, 65, 70, 97, 102, 46, 47, 53,
-	58, 48, 52, 54, 57, 65, 70, 97,
-	102, 46, 47, 58, 48, 53, 54, 57,
-	65, 70, 97, 102, 46, 47, 58, 48,
+	57, 65, 70, 97, 102, 47, 58, 48,
+	57, 65, 70, 97, 102, 47, 58, 48,
 	57, 65, 70, 97, 102, 46, 47, 58,
-	48, 57, 65, 70, 97, 102, 47, 58,
-	48, 57, 65, 70, 97, 102, 61, 61,
-	126, 61, 58, 78, 95, 110, 45, 46,
-	48, 57, 65, 70, 71, 90, 97, 102,
-	103, 122, 95, 45, 46, 48, 57, 65,
-	90, 97, 122, 58, 95, 45, 46, 48,
-	57, 65, 70, 71, 90, 97, 102, 103,
-	122, 58, 95, 45, 46, 48, 57, 65,
-	70, 71, 90, 97, 102, 103, 122, 58,
-	95, 45, 46, 48, 57, 65, 90, 97,
-	122, 95, 45, 46, 48, 57, 65, 90,
-	97, 122, 68, 95, 100, 45, 46, 48,
-	57, 65, 90, 97, 122, 58, 95, 45,
-	46, 48, 57, 65, 70, 71, 90, 97,
-	102, 103, 122, 58, 79, 95, 111, 45,
-	46, 48, 57, 65, 70, 71, 90, 97,
-	102, 103, 122, 78, 95, 110, 45, 46,
-	48, 57, 65, 90, 97, 122, 84, 95,
-	116, 45, 46, 48, 57, 65, 90, 97,
-	122, 65, 95, 97, 45, 46, 48, 57,
-	66, 90, 98, 122, 73, 95, 105, 45,
-	46, 48, 57, 65, 90, 97, 122, 78,
-	95, 110, 45, 46, 48, 57, 65, 90,
-	97, 122, 83, 95, 115, 45, 46, 48,
-	57, 65, 90, 97, 122, 58, 81, 95,
-	113, 45, 46, 48, 57, 65, 70, 71,
-	90, 97, 102, 103, 122, 58, 65, 95,
-	97, 45, 46, 48, 57, 66, 70, 71,
-	90, 98, 102, 103, 122, 58, 76, 95,
-	108, 45, 46, 48, 57, 65, 70, 71,
-	90, 97, 102, 103, 122, 83, 95, 115,
-	45, 46, 48, 57, 65, 90, 97, 122,
-	69, 95, 101, 45, 46, 48, 57, 65,
-	90, 97, 122, 69, 84, 95, 101, 116,
-	45, 46, 48, 57, 65, 90, 97, 122,
-	78, 95, 110, 45, 46, 48, 57, 65,
-	90, 97, 122, 69, 84, 95, 101, 116,
-	45, 46, 48, 57, 65, 90, 97, 122,
-	65, 95, 97, 45, 46, 48, 57, 66,
-	90, 98, 122, 84, 95, 116, 45, 46,
-	48, 57, 65, 90, 97, 122, 67, 95,
-	99, 45, 46, 48, 57, 65, 90, 97,
-	122, 72, 95, 104, 45, 46, 48, 57,
-	65, 90, 97, 122, 69, 95, 101, 45,
-	46, 48, 57, 65, 90, 97, 122, 83,
-	95, 115, 45, 46, 48, 57, 65, 90,
-	97, 122, 69, 79, 95, 101, 111, 45,
-	46, 48, 57, 65, 90, 97, 122, 84,
-	95, 116, 45, 46, 48, 57, 65, 90,
-	97, 122, 82, 95, 114, 45, 46, 48,
-	57, 65, 90, 97, 122, 82, 95, 114,
-	45, 46, 48, 57, 65, 90, 97, 122,
-	85, 95, 117, 45, 46, 48, 57, 65,
-	90, 97, 122, 92, 124,
+	48, 57, 65, 70, 97, 102, 42, 45,
+	46, 91, 95, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 45, 46, 91,
+	95, 48, 57, 65, 70, 71, 90, 97,
+	102, 103, 122, 43, 45, 48, 57, 65,
+	70, 97, 102, 47, 48, 57, 65, 70,
+	97, 102, 47, 58, 48, 57, 65, 70,
+	97, 102, 46, 47, 58, 48, 57, 65,
+	70, 97, 102, 46, 47, 58, 48, 53,
+	54, 57, 65, 70, 97, 102, 46, 47,
+	58, 48, 57, 65, 70, 97, 102, 47,
+	58, 45, 46, 91, 95, 48, 57, 65,
+	70, 71, 90, 97, 102, 103, 122, 45,
+	46, 91, 95, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 43, 45, 48,
+	57, 47, 58, 48, 57, 65, 70, 97,
+	102, 47, 48, 57, 65, 70, 97, 102,
+	47, 58, 48, 57, 65, 70, 97, 102,
+	46, 47, 58, 48, 57, 65, 70, 97,
+	102, 45, 46, 91, 95, 48, 57, 65,
+	90, 97, 122, 45, 46, 91, 95, 48,
+	57, 65, 90, 97, 122, 47, 48, 57,
+	65, 70, 97, 102, 47, 58, 42, 45,
+	46, 91, 95, 48, 57, 65, 70, 71,
+	90, 97, 102, 103, 122, 47, 48, 57,
+	65, 70, 97, 102, 47, 58, 48, 57,
+	65, 70, 97, 102, 58, 48, 57, 65,
+	70, 97, 102, 46, 58, 48, 57, 65,
+	70, 97, 102, 46, 58, 48, 53, 54,
+	57, 65, 70, 97, 102, 46, 58, 48,
+	57, 65, 70, 97, 102, 47, 48, 57,
+	65, 70, 97, 102, 47, 58, 48, 57,
+	65, 70, 97, 102, 58,
 }
 
 var _ruleLexerImpl_single_lengths []byte = []byte{
-	2, 2, 2, 2, 0, 2, 0, 3,
-	3, 1, 2, 1, 3, 3, 1, 3,
-	0, 1, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 4, 2,
-	3, 1, 1, 1, 2, 1, 1, 1,
-	1, 1, 2, 2, 2, 3, 2, 2,
-	2, 1, 0, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 2, 1, 0, 0, 2,
-	3, 2, 1, 1, 3, 2, 0, 46,
-	1, 2, 1, 2, 1, 0, 2, 1,
-	3, 1, 2, 1, 1, 0, 1, 1,
-	2, 1, 1, 1, 2, 1, 2, 2,
-	2, 1, 1, 1, 1, 4, 3, 2,
-	2, 2, 3, 3, 3, 4, 3, 3,
-	3, 2, 4, 3, 2, 2, 2, 3,
-	3, 3, 4, 3, 3, 3, 2, 4,
-	3, 2, 2, 2, 3, 3, 3, 4,
-	3, 3, 3, 2, 4, 3, 2, 2,
-	2, 3, 3, 3, 4, 3, 3, 3,
-	2, 4, 3, 2, 2, 2, 3, 3,
-	3, 4, 3, 3, 3, 2, 1, 1,
-	1, 1, 1, 1, 1, 2, 1, 2,
-	2, 2, 1, 2, 2, 2, 3, 2,
-	2, 2, 1, 4, 3, 2, 2, 2,
-	3, 3, 3, 4, 3, 3, 3, 2,
-	1, 2, 1, 4, 1, 2, 2, 2,
-	1, 3, 2, 4, 3, 3, 3, 3,
-	3, 3, 4, 4, 4, 3, 3, 5,
-	3, 5, 3, 3, 3, 3, 3, 3,
-	5, 3, 3, 3, 3, 2,
+	2, 2, 2, 2, 2, 3, 0, 3,
+	1, 2, 1, 1, 0, 1, 1, 1,
+	2, 0, 2, 0, 2, 0, 3, 1,
+	0, 0, 1, 1, 3, 1, 0, 0,
+	0, 1, 0, 0, 7, 0, 1, 4,
+	1, 2, 3, 0, 0, 2, 4, 1,
+	1, 1, 0, 3, 3, 3, 0, 1,
+	0, 0, 0, 1, 1, 3, 6, 1,
+	1, 2, 1, 1, 1, 3, 0, 0,
+	0, 1, 1, 1, 1, 3, 1, 0,
+	1, 0, 2, 1, 1, 1, 3, 1,
+	1, 2, 1, 1, 0, 0, 2, 1,
+	1, 1, 1, 1, 0, 1, 3, 0,
+	2, 1, 1, 3, 0, 1, 0, 0,
+	1, 1, 1, 1, 1, 0, 0, 1,
+	1, 3, 1, 0, 0, 2, 1, 1,
+	4, 0, 1, 0, 1, 1, 2, 2,
+	3, 2, 1, 0, 1, 0, 1, 1,
+	1, 2, 2, 2, 1, 0, 2, 1,
+	2, 1, 1, 1, 0, 0, 4, 4,
+	2, 2, 3, 2, 1, 0, 0, 0,
+	3, 1, 1, 0, 0, 0, 0, 61,
+	1, 2, 1, 2, 1, 1, 3, 26,
+	20, 21, 20, 1, 1, 2, 1, 1,
+	11, 5, 7, 11, 7, 10, 6, 14,
+	4, 8, 6, 10, 6, 8, 2, 2,
+	1, 3, 3, 21, 2, 3, 3, 3,
+	0, 1, 21, 2, 21, 21, 4, 4,
+	6, 5, 6, 10, 6, 6, 4, 6,
+	7, 4, 6, 4, 6, 6, 6, 4,
+	6, 4, 4, 6, 4, 8, 6, 4,
+	6, 6, 2, 3, 2, 2, 4, 16,
+	16, 17, 16, 20, 1, 4, 2, 1,
+	1, 1, 10, 20, 3, 3, 4, 3,
+	2, 4, 5, 7, 4, 6, 6, 6,
+	6, 8, 6, 6, 4, 4, 6, 6,
+	4, 6, 6, 6, 0, 4, 16, 16,
+	18, 2, 2, 3, 3, 4, 3, 2,
+	3, 1, 0, 0, 2, 3, 3, 3,
+	4, 5, 6, 4, 6, 4, 6, 6,
+	6, 4, 4, 4, 4, 1, 3, 3,
+	4, 3, 9, 16, 1, 4, 2, 3,
+	3, 3, 1, 2, 3, 4, 6, 6,
+	6, 6, 6, 6, 2, 3, 19, 3,
+	3, 4, 3, 2, 2, 3, 2, 4,
+	4, 6, 4, 6, 6, 6, 2, 3,
+	1, 1, 2, 1, 19, 0, 4, 2,
+	3, 3, 3, 2, 4, 4, 6, 8,
+	6, 4, 1, 19, 0, 0, 2, 3,
+	3, 4, 3, 2, 2, 3, 4, 4,
+	4, 4, 6, 16, 1, 1, 4, 2,
+	3, 3, 3, 2, 5, 4, 4, 0,
+	3, 3, 4, 3, 2, 2, 3, 4,
+	6, 2, 2, 4, 2, 3, 3, 3,
+	2, 4, 4, 2, 3, 3, 4, 3,
+	2, 2, 3, 5, 4, 2, 1, 2,
+	3, 3, 3, 2, 4, 4, 2, 2,
+	1, 2, 3, 4, 4, 1, 2, 5,
+	1, 2, 1, 2, 2, 2, 1, 2,
+	1,
 }
 
 var _ruleLexerImpl_range_lengths []byte = []byte{
-	0, 0, 0, 0, 1, 0, 0, 0,
-	0, 0, 0, 0, 1, 1, 0, 1,
-	1, 1, 1, 2, 1, 3, 3, 3,
-	3, 0, 3, 3, 3, 3, 0, 3,
-	3, 3, 3, 0, 3, 3, 3, 3,
-	0, 3, 3, 3, 3, 0, 3, 3,
-	1, 0, 1, 1, 2, 1, 3, 3,
-	0, 3, 3, 3, 3, 4, 4, 3,
-	3, 3, 3, 3, 3, 3, 3, 0,
-	3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3,
-	4, 3, 3, 3, 3, 0, 0, 6,
-	0, 0, 0, 0, 1, 1, 1, 0,
-	0, 0, 3, 1, 0, 1, 1, 1,
-	2, 1, 1, 1, 2, 2, 3, 3,
-	1, 3, 3, 3, 3, 3, 3, 3,
-	3, 0, 3, 3, 3, 4, 4, 3,
-	3, 3, 3, 3, 3, 3, 0, 3,
-	3, 3, 4, 4, 3, 3, 3, 3,
-	3, 3, 3, 0, 3, 3, 3, 4,
-	4, 3, 3, 3, 3, 3, 3, 3,
-	0, 3, 3, 3, 4, 4, 3, 3,
+	3, 3, 3, 3, 3, 4, 1, 1,
+	3, 0, 1, 3, 1, 0, 0, 0,
+	3, 1, 3, 1, 1, 1, 4, 2,
+	3, 1, 3, 3, 3, 3, 1, 1,
+	3, 0, 1, 3, 1, 1, 0, 3,
+	2, 3, 1, 1, 3, 0, 1, 3,
+	3, 3, 1, 1, 3, 1, 1, 0,
+	1, 3, 3, 3, 3, 3, 1, 0,
+	1, 2, 1, 3, 3, 1, 3, 1,
+	3, 3, 0, 3, 3, 1, 1, 3,
+	0, 3, 3, 3, 3, 3, 3, 0,
+	1, 2, 1, 0, 1, 3, 3, 0,
+	3, 3, 1, 3, 1, 0, 1, 1,
 	3, 3, 3, 3, 3, 0, 3, 3,
-	3, 4, 4, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 0, 3,
-	4, 3, 3, 3, 3, 3, 4, 4,
-	3, 3, 0, 3, 3, 3, 3, 0,
-	3, 3, 3, 4, 4, 3, 3, 3,
-	0, 0, 0, 6, 4, 6, 6, 4,
-	4, 4, 6, 6, 4, 4, 4, 4,
-	4, 4, 6, 6, 6, 4, 4, 4,
-	4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 0,
+	3, 0, 3, 3, 0, 1, 3, 3,
+	3, 3, 3, 1, 3, 3, 0, 3,
+	3, 3, 0, 3, 3, 3, 3, 3,
+	4, 3, 3, 3, 0, 1, 0, 3,
+	3, 3, 4, 3, 3, 1, 3, 3,
+	3, 0, 3, 0, 1, 1, 3, 0,
+	3, 3, 4, 3, 3, 1, 1, 1,
+	1, 3, 0, 1, 1, 3, 3, 13,
+	0, 3, 0, 3, 0, 1, 4, 3,
+	3, 4, 3, 0, 0, 0, 0, 0,
+	7, 5, 7, 11, 5, 9, 5, 7,
+	3, 7, 3, 9, 5, 7, 3, 3,
+	2, 1, 0, 3, 3, 4, 3, 4,
+	1, 1, 3, 3, 4, 3, 3, 3,
+	3, 5, 5, 5, 5, 5, 3, 5,
+	7, 3, 5, 3, 5, 5, 5, 3,
+	5, 3, 3, 5, 3, 7, 5, 3,
+	5, 5, 0, 0, 3, 3, 5, 1,
+	1, 2, 1, 3, 3, 3, 4, 3,
+	1, 3, 1, 3, 3, 3, 4, 3,
+	3, 5, 5, 4, 3, 5, 5, 5,
+	5, 3, 5, 5, 3, 3, 5, 5,
+	3, 5, 5, 5, 1, 5, 1, 2,
+	1, 0, 1, 3, 3, 4, 3, 3,
+	1, 1, 3, 1, 3, 3, 4, 3,
+	5, 3, 3, 3, 5, 3, 5, 5,
+	5, 3, 3, 3, 3, 2, 1, 1,
+	2, 1, 1, 1, 3, 3, 3, 3,
+	4, 3, 1, 3, 3, 3, 5, 5,
+	5, 5, 3, 5, 1, 2, 3, 3,
+	3, 4, 3, 3, 3, 3, 0, 5,
+	5, 5, 3, 5, 5, 5, 3, 3,
+	0, 1, 2, 1, 3, 3, 3, 3,
+	3, 4, 3, 0, 5, 5, 5, 3,
+	5, 3, 1, 3, 3, 3, 3, 3,
+	3, 4, 3, 3, 3, 3, 3, 5,
+	3, 3, 5, 1, 0, 1, 3, 3,
+	3, 4, 3, 0, 5, 3, 3, 1,
+	3, 3, 4, 3, 3, 3, 3, 5,
+	5, 3, 3, 3, 3, 3, 4, 3,
+	0, 3, 5, 3, 3, 3, 4, 3,
+	3, 3, 3, 5, 5, 3, 3, 3,
+	3, 4, 3, 0, 5, 5, 1, 3,
+	3, 3, 3, 3, 3, 3, 0, 5,
+	3, 3, 3, 3, 4, 3, 3, 3,
+	0,
 }
 
 var _ruleLexerImpl_index_offsets []int16 = []int16{
-	0, 3, 6, 9, 12, 14, 17, 18,
-	22, 26, 28, 31, 33, 38, 43, 45,
-	50, 52, 55, 58, 63, 66, 71, 76,
-	81, 86, 88, 93, 98, 103, 108, 110,
-	115, 120, 125, 130, 132, 137, 142, 147,
-	152, 154, 159, 164, 169, 174, 176, 184,
-	190, 195, 197, 200, 203, 208, 211, 216,
-	221, 223, 228, 234, 240, 246, 254, 261,
-	267, 273, 278, 282, 289, 296, 303, 310,
-	312, 317, 322, 327, 332, 337, 342, 347,
-	352, 357, 362, 370, 376, 381, 385, 389,
-	395, 403, 409, 414, 419, 426, 429, 430,
-	483, 485, 488, 490, 493, 496, 498, 502,
-	504, 508, 510, 516, 519, 521, 523, 526,
-	529, 534, 537, 540, 543, 548, 552, 558,
-	564, 568, 573, 578, 583, 588, 596, 603,
-	609, 615, 618, 625, 632, 639, 648, 656,
-	663, 670, 676, 684, 691, 697, 703, 706,
-	713, 720, 727, 736, 744, 751, 758, 764,
-	772, 779, 785, 791, 794, 801, 808, 815,
-	824, 832, 839, 846, 852, 860, 867, 873,
-	879, 882, 889, 896, 903, 912, 920, 927,
-	934, 940, 948, 955, 961, 967, 970, 977,
-	984, 991, 1000, 1008, 1015, 1022, 1028, 1033,
-	1038, 1043, 1048, 1053, 1058, 1063, 1069, 1071,
-	1077, 1084, 1090, 1095, 1101, 1107, 1113, 1121,
-	1128, 1134, 1140, 1142, 1150, 1157, 1163, 1169,
-	1172, 1179, 1186, 1193, 1202, 1210, 1217, 1224,
-	1230, 1232, 1235, 1237, 1248, 1254, 1263, 1272,
-	1279, 1285, 1293, 1302, 1313, 1321, 1329, 1337,
-	1345, 1353, 1361, 1372, 1383, 1394, 1402, 1410,
-	1420, 1428, 1438, 1446, 1454, 1462, 1470, 1478,
-	1486, 1496, 1504, 1512, 1520, 1528,
+	0, 5, 10, 15, 20, 25, 32, 33,
+	38, 43, 46, 49, 54, 56, 58, 60,
+	62, 67, 68, 73, 74, 78, 80, 87,
+	90, 94, 96, 101, 106, 113, 118, 120,
+	122, 126, 128, 130, 134, 143, 145, 147,
+	155, 158, 163, 168, 170, 174, 177, 183,
+	188, 193, 198, 200, 205, 212, 217, 219,
+	221, 223, 227, 231, 236, 241, 248, 256,
+	258, 261, 266, 269, 274, 279, 284, 288,
+	290, 294, 299, 301, 306, 311, 316, 319,
+	323, 325, 329, 335, 340, 345, 350, 357,
+	359, 362, 367, 370, 372, 374, 378, 384,
+	386, 391, 396, 399, 404, 406, 408, 413,
+	415, 421, 426, 431, 438, 442, 444, 448,
+	452, 457, 459, 464, 469, 471, 473, 477,
+	482, 487, 494, 499, 501, 505, 511, 513,
+	518, 526, 530, 532, 536, 541, 546, 552,
+	558, 566, 572, 577, 581, 583, 585, 587,
+	592, 597, 603, 610, 616, 621, 623, 629,
+	634, 640, 642, 647, 649, 651, 653, 661,
+	666, 672, 678, 686, 692, 697, 699, 701,
+	703, 708, 713, 715, 717, 719, 723, 727,
+	801, 803, 808, 810, 815, 817, 820, 827,
+	857, 881, 907, 931, 933, 935, 938, 940,
+	942, 961, 972, 987, 1010, 1023, 1043, 1055,
+	1077, 1085, 1101, 1111, 1131, 1143, 1159, 1164,
+	1169, 1172, 1177, 1181, 1206, 1212, 1220, 1227,
+	1235, 1237, 1240, 1265, 1271, 1297, 1322, 1330,
+	1338, 1348, 1359, 1371, 1387, 1399, 1411, 1419,
+	1431, 1446, 1454, 1466, 1474, 1486, 1498, 1510,
+	1518, 1530, 1538, 1546, 1558, 1566, 1582, 1594,
+	1602, 1614, 1626, 1629, 1633, 1638, 1643, 1652,
+	1670, 1688, 1708, 1726, 1750, 1755, 1763, 1770,
+	1775, 1778, 1783, 1795, 1819, 1826, 1833, 1842,
+	1849, 1855, 1865, 1876, 1888, 1896, 1908, 1920,
+	1932, 1944, 1956, 1968, 1980, 1988, 1996, 2008,
+	2020, 2028, 2040, 2052, 2064, 2066, 2075, 2093,
+	2112, 2132, 2135, 2139, 2146, 2153, 2162, 2169,
+	2175, 2180, 2183, 2187, 2189, 2195, 2202, 2210,
+	2217, 2227, 2236, 2246, 2254, 2266, 2274, 2286,
+	2298, 2310, 2318, 2326, 2334, 2342, 2345, 2350,
+	2355, 2362, 2367, 2378, 2396, 2401, 2409, 2415,
+	2422, 2430, 2437, 2440, 2446, 2453, 2461, 2473,
+	2485, 2497, 2509, 2519, 2531, 2535, 2541, 2564,
+	2571, 2578, 2587, 2594, 2600, 2606, 2613, 2616,
+	2626, 2636, 2648, 2656, 2668, 2680, 2692, 2698,
+	2705, 2707, 2710, 2715, 2718, 2741, 2745, 2753,
+	2759, 2766, 2774, 2781, 2784, 2794, 2804, 2816,
+	2828, 2840, 2848, 2851, 2874, 2878, 2882, 2888,
+	2895, 2902, 2911, 2918, 2924, 2930, 2937, 2945,
+	2955, 2963, 2971, 2983, 3001, 3003, 3006, 3014,
+	3020, 3027, 3035, 3042, 3045, 3056, 3064, 3072,
+	3074, 3081, 3088, 3097, 3104, 3110, 3116, 3123,
+	3133, 3145, 3151, 3157, 3165, 3171, 3178, 3186,
+	3193, 3196, 3204, 3214, 3220, 3227, 3234, 3243,
+	3250, 3256, 3262, 3269, 3280, 3290, 3296, 3301,
+	3307, 3314, 3322, 3329, 3332, 3342, 3352, 3356,
+	3362, 3367, 3373, 3380, 3388, 3396, 3401, 3404,
+	3415, 3420, 3426, 3431, 3437, 3444, 3450, 3455,
+	3461,
 }
 
 var _ruleLexerImpl_indicies []int16 = []int16{
-	2, 3, 1, 4, 3, 1, 2, 6,
-	5, 7, 6, 5, 8, 0, 11, 12,
-	10, 10, 14, 15, 16, 13, 14, 17,
-	16, 13, 19, 18, 19, 17, 18, 14,
-	13, 21, 22, 23, 24, 20, 25, 26,
-	27, 28, 0, 29, 0, 30, 31, 32,
-	33, 0, 35, 34, 29, 28, 0, 29,
-	25, 0, 29, 36, 28, 25, 0, 29,
-	25, 0, 38, 37, 37, 37, 0, 40,
-	39, 39, 39, 0, 40, 41, 41, 41,
-	0, 40, 42, 42, 42, 0, 40, 0,
-	44, 43, 43, 43, 0, 46, 45, 45,
-	45, 0, 46, 47, 47, 47, 0, 46,
-	48, 48, 48, 0, 46, 0, 50, 49,
-	49, 49, 0, 52, 51, 51, 51, 0,
-	52, 53, 53, 53, 0, 52, 54, 54,
-	54, 0, 52, 0, 56, 55, 55, 55,
-	0, 58, 57, 57, 57, 0, 58, 59,
-	59, 59, 0, 58, 60, 60, 60, 0,
-	58, 0, 62, 61, 61, 61, 0, 64,
-	63, 63, 63, 0, 64, 65, 65, 65,
-	0, 64, 66, 66, 66, 0, 64, 0,
-	67, 68, 69, 71, 70, 72, 72, 0,
-	73, 75, 74, 74, 74, 0, 76, 77,
-	78, 79, 0, 80, 0, 80, 79, 0,
-	80, 76, 0, 80, 81, 79, 76, 0,
-	80, 76, 0, 75, 82, 82, 82, 0,
-	75, 83, 83, 83, 0, 75, 0, 30,
-	84, 84, 84, 0, 73, 75, 85, 74,
-	74, 0, 73, 75, 86, 82, 82, 0,
-	73, 75, 83, 83, 83, 0, 73, 87,
-	75, 85, 88, 74, 74, 0, 73, 75,
-	86, 82, 82, 82, 0, 73, 75, 82,
-	82, 82, 0, 73, 75, 88, 74, 74,
-	0, 75, 74, 74, 74, 0, 84, 84,
-	84, 34, 89, 90, 91, 92, 93, 93,
-	34, 94, 95, 96, 97, 98, 98, 34,
-	99, 100, 101, 102, 103, 103, 34, 104,
-	105, 106, 107, 108, 108, 34, 109, 0,
-	38, 110, 110, 110, 0, 40, 111, 111,
-	111, 0, 44, 113, 113, 113, 112, 46,
-	114, 114, 114, 112, 50, 115, 115, 115,
-	112, 52, 116, 116, 116, 112, 56, 117,
-	117, 117, 112, 58, 118, 118, 118, 112,
-	62, 119, 119, 119, 112, 64, 120, 120,
-	120, 112, 121, 122, 123, 71, 124, 125,
-	125, 112, 73, 75, 126, 126, 126, 112,
-	30, 127, 127, 127, 112, 128, 128, 128,
-	0, 129, 129, 129, 0, 73, 75, 130,
-	126, 126, 112, 73, 131, 75, 130, 132,
-	126, 126, 112, 73, 75, 132, 126, 126,
-	112, 75, 126, 126, 126, 112, 109, 133,
-	133, 133, 0, 134, 135, 136, 137, 138,
-	138, 34, 140, 11, 139, 139, 142, 142,
-	143, 144, 145, 146, 147, 148, 149, 150,
-	151, 152, 153, 154, 155, 156, 158, 159,
-	160, 161, 162, 164, 165, 166, 167, 169,
-	170, 171, 172, 173, 174, 175, 176, 168,
-	162, 164, 165, 166, 167, 169, 170, 171,
-	172, 173, 174, 177, 142, 157, 163, 168,
-	163, 168, 141, 179, 178, 2, 3, 1,
-	181, 180, 2, 6, 5, 182, 183, 0,
-	8, 0, 184, 182, 183, 180, 142, 184,
-	13, 11, 12, 10, 19, 18, 187, 109,
-	188, 189, 189, 186, 80, 8, 190, 192,
-	191, 194, 193, 192, 33, 191, 192, 30,
-	191, 192, 195, 33, 30, 191, 192, 30,
-	191, 80, 24, 190, 80, 21, 190, 80,
-	196, 24, 21, 190, 80, 21, 8, 190,
-	182, 198, 197, 199, 199, 186, 182, 109,
-	200, 133, 133, 186, 182, 109, 183, 186,
-	192, 201, 201, 201, 191, 192, 202, 202,
-	202, 191, 192, 30, 30, 30, 191, 192,
-	84, 84, 84, 191, 192, 89, 90, 91,
-	92, 93, 93, 191, 73, 192, 204, 203,
-	203, 203, 191, 192, 204, 205, 205, 205,
-	191, 192, 204, 206, 206, 206, 191, 192,
-	204, 191, 73, 192, 204, 207, 203, 203,
-	191, 73, 192, 204, 208, 205, 205, 191,
-	73, 192, 204, 206, 206, 206, 191, 73,
-	192, 209, 204, 207, 210, 203, 203, 191,
-	73, 192, 204, 208, 205, 205, 205, 191,
-	73, 192, 204, 205, 205, 205, 191, 73,
-	192, 204, 210, 203, 203, 191, 192, 204,
-	203, 203, 203, 191, 192, 94, 95, 96,
-	97, 98, 98, 191, 73, 192, 212, 211,
-	211, 211, 191, 192, 212, 213, 213, 213,
-	191, 192, 212, 214, 214, 214, 191, 192,
-	212, 191, 73, 192, 212, 215, 211, 211,
-	191, 73, 192, 212, 216, 213, 213, 191,
-	73, 192, 212, 214, 214, 214, 191, 73,
-	192, 217, 212, 215, 218, 211, 211, 191,
-	73, 192, 212, 216, 213, 213, 213, 191,
-	73, 192, 212, 213, 213, 213, 191, 73,
-	192, 212, 218, 211, 211, 191, 192, 212,
-	211, 211, 211, 191, 192, 99, 100, 101,
-	102, 103, 103, 191, 73, 192, 220, 219,
-	219, 219, 191, 192, 220, 221, 221, 221,
-	191, 192, 220, 222, 222, 222, 191, 192,
-	220, 191, 73, 192, 220, 223, 219, 219,
-	191, 73, 192, 220, 224, 221, 221, 191,
-	73, 192, 220, 222, 222, 222, 191, 73,
-	192, 225, 220, 223, 226, 219, 219, 191,
-	73, 192, 220, 224, 221, 221, 221, 191,
-	73, 192, 220, 221, 221, 221, 191, 73,
-	192, 220, 226, 219, 219, 191, 192, 220,
-	219, 219, 219, 191, 192, 104, 105, 106,
-	107, 108, 108, 191, 73, 192, 228, 227,
-	227, 227, 191, 192, 228, 229, 229, 229,
-	191, 192, 228, 230, 230, 230, 191, 192,
-	228, 191, 73, 192, 228, 231, 227, 227,
-	191, 73, 192, 228, 232, 229, 229, 191,
-	73, 192, 228, 230, 230, 230, 191, 73,
-	192, 233, 228, 231, 234, 227, 227, 191,
-	73, 192, 228, 232, 229, 229, 229, 191,
-	73, 192, 228, 229, 229, 229, 191, 73,
-	192, 228, 234, 227, 227, 191, 192, 228,
-	227, 227, 227, 191, 192, 134, 135, 136,
-	137, 138, 138, 191, 73, 192, 236, 235,
-	235, 235, 191, 192, 236, 237, 237, 237,
-	191, 192, 236, 238, 238, 238, 191, 192,
-	236, 191, 73, 192, 236, 239, 235, 235,
-	191, 73, 192, 236, 240, 237, 237, 191,
-	73, 192, 236, 238, 238, 238, 191, 73,
-	192, 241, 236, 239, 242, 235, 235, 191,
-	73, 192, 236, 240, 237, 237, 237, 191,
-	73, 192, 236, 237, 237, 237, 191, 73,
-	192, 236, 242, 235, 235, 191, 192, 236,
-	235, 235, 235, 191, 244, 41, 41, 41,
-	243, 245, 47, 47, 47, 243, 246, 53,
-	53, 53, 243, 247, 59, 59, 59, 243,
-	248, 65, 65, 65, 243, 249, 82, 82,
-	82, 243, 192, 250, 250, 250, 191, 192,
-	251, 202, 202, 202, 191, 251, 243, 73,
-	249, 86, 82, 82, 243, 73, 249, 86,
-	82, 82, 82, 243, 73, 249, 82, 82,
-	82, 243, 198, 199, 199, 199, 243, 187,
-	109, 252, 189, 189, 186, 187, 198, 253,
-	199, 199, 186, 187, 109, 200, 133, 133,
-	186, 187, 254, 109, 252, 255, 189, 189,
-	186, 187, 198, 253, 197, 199, 199, 186,
-	187, 198, 197, 199, 199, 186, 187, 109,
-	255, 189, 189, 186, 256, 180, 192, 257,
-	258, 259, 260, 261, 261, 191, 73, 192,
-	263, 262, 262, 262, 191, 192, 263, 264,
-	264, 264, 191, 192, 263, 265, 265, 265,
-	191, 192, 263, 191, 73, 192, 263, 266,
-	262, 262, 191, 73, 192, 263, 267, 264,
-	264, 191, 73, 192, 263, 265, 265, 265,
-	191, 73, 192, 268, 263, 266, 269, 262,
-	262, 191, 73, 192, 263, 267, 264, 264,
-	264, 191, 73, 192, 263, 264, 264, 264,
-	191, 73, 192, 263, 269, 262, 262, 191,
-	192, 263, 262, 262, 262, 191, 271, 270,
-	272, 273, 180, 275, 274, 109, 279, 168,
-	279, 277, 278, 278, 168, 278, 168, 276,
-	277, 277, 277, 277, 277, 280, 198, 168,
-	277, 281, 281, 168, 281, 168, 243, 109,
-	168, 277, 282, 282, 168, 282, 168, 276,
-	109, 168, 277, 168, 168, 168, 276, 168,
-	277, 168, 168, 168, 0, 283, 168, 283,
-	277, 168, 168, 168, 276, 109, 168, 277,
-	278, 278, 168, 278, 168, 276, 109, 284,
-	168, 284, 277, 278, 278, 168, 278, 168,
-	276, 285, 168, 285, 277, 168, 168, 168,
-	276, 286, 168, 286, 277, 168, 168, 168,
-	276, 287, 168, 287, 277, 168, 168, 168,
-	276, 288, 168, 288, 277, 168, 168, 168,
-	276, 289, 168, 289, 277, 168, 168, 168,
-	276, 290, 168, 290, 277, 168, 168, 168,
-	276, 109, 291, 168, 291, 277, 278, 278,
-	168, 278, 168, 276, 109, 292, 168, 292,
-	277, 278, 278, 168, 278, 168, 276, 198,
-	293, 168, 293, 277, 281, 281, 168, 281,
-	168, 243, 294, 168, 294, 277, 168, 168,
-	168, 276, 295, 168, 295, 277, 168, 168,
-	168, 276, 296, 297, 168, 296, 297, 277,
-	168, 168, 168, 276, 298, 168, 298, 277,
-	168, 168, 168, 276, 299, 300, 168, 299,
-	300, 277, 168, 168, 168, 276, 301, 168,
-	301, 277, 168, 168, 168, 276, 302, 168,
-	302, 277, 168, 168, 168, 276, 303, 168,
-	303, 277, 168, 168, 168, 276, 304, 168,
-	304, 277, 168, 168, 168, 276, 305, 168,
-	305, 277, 168, 168, 168, 276, 306, 168,
-	306, 277, 168, 168, 168, 276, 307, 308,
-	168, 307, 308, 277, 168, 168, 168, 276,
-	309, 168, 309, 277, 168, 168, 168, 276,
-	310, 168, 310, 277, 168, 168, 168, 276,
-	311, 168, 311, 277, 168, 168, 168, 276,
-	294, 168, 294, 277, 168, 168, 168, 276,
-	140, 312, 139,
+	0, 1, 2, 2, 2, 3, 1, 2,
+	2, 2, 0, 4, 5, 5, 5, 6,
+	4, 5, 5, 5, 7, 8, 9, 9,
+	9, 10, 11, 12, 13, 13, 13, 13,
+	9, 14, 15, 16, 17, 18, 19, 20,
+	20, 20, 21, 22, 23, 21, 24, 25,
+	18, 26, 27, 27, 27, 18, 28, 18,
+	29, 21, 30, 21, 31, 21, 32, 33,
+	34, 34, 34, 34, 35, 7, 36, 36,
+	36, 36, 37, 37, 38, 21, 39, 40,
+	10, 41, 12, 13, 13, 13, 13, 10,
+	13, 13, 42, 42, 42, 21, 43, 18,
+	19, 44, 44, 44, 21, 45, 46, 46,
+	46, 21, 37, 37, 45, 47, 46, 46,
+	18, 48, 49, 49, 49, 21, 50, 21,
+	38, 21, 51, 42, 42, 52, 22, 21,
+	25, 18, 27, 27, 27, 18, 53, 29,
+	54, 30, 29, 30, 55, 56, 57, 58,
+	59, 33, 21, 33, 60, 60, 34, 60,
+	60, 60, 61, 62, 63, 63, 62, 64,
+	63, 63, 63, 65, 66, 67, 68, 40,
+	69, 21, 70, 70, 70, 21, 71, 45,
+	21, 37, 37, 71, 45, 38, 18, 48,
+	72, 72, 72, 21, 48, 73, 73, 73,
+	21, 74, 75, 75, 75, 21, 76, 57,
+	77, 78, 79, 80, 21, 81, 82, 83,
+	84, 85, 85, 59, 86, 86, 87, 86,
+	88, 89, 40, 90, 21, 91, 18, 92,
+	93, 93, 18, 93, 93, 93, 21, 48,
+	94, 94, 94, 21, 95, 96, 96, 96,
+	21, 97, 98, 99, 100, 101, 101, 59,
+	29, 54, 30, 29, 30, 55, 76, 57,
+	102, 21, 102, 80, 21, 102, 103, 80,
+	77, 21, 102, 77, 21, 104, 104, 104,
+	104, 21, 105, 105, 105, 105, 21, 106,
+	107, 108, 109, 21, 110, 110, 110, 21,
+	111, 18, 112, 112, 112, 21, 74, 113,
+	113, 113, 52, 48, 21, 95, 114, 114,
+	114, 21, 115, 116, 116, 116, 21, 117,
+	118, 119, 120, 21, 102, 77, 21, 121,
+	121, 121, 21, 122, 18, 123, 123, 123,
+	21, 37, 37, 124, 123, 123, 40, 95,
+	125, 125, 125, 52, 95, 126, 126, 126,
+	21, 127, 128, 128, 128, 21, 129, 130,
+	131, 132, 133, 133, 59, 134, 21, 134,
+	120, 21, 134, 135, 120, 117, 21, 134,
+	117, 21, 136, 21, 137, 18, 138, 138,
+	138, 21, 37, 37, 139, 138, 138, 40,
+	95, 21, 127, 140, 140, 140, 21, 141,
+	142, 142, 142, 21, 134, 117, 21, 143,
+	144, 144, 144, 21, 145, 18, 146, 21,
+	37, 37, 146, 38, 40, 147, 21, 143,
+	115, 148, 148, 148, 52, 127, 149, 149,
+	149, 21, 150, 151, 151, 151, 21, 152,
+	153, 154, 155, 156, 156, 59, 157, 157,
+	157, 21, 158, 18, 159, 160, 160, 40,
+	160, 160, 160, 21, 127, 161, 161, 161,
+	52, 127, 21, 150, 162, 162, 162, 21,
+	163, 164, 164, 164, 21, 165, 21, 166,
+	18, 167, 167, 167, 21, 150, 168, 168,
+	168, 21, 169, 170, 170, 170, 21, 171,
+	172, 173, 174, 175, 175, 59, 143, 176,
+	176, 176, 21, 177, 18, 178, 178, 178,
+	21, 143, 141, 179, 179, 179, 52, 150,
+	21, 169, 180, 180, 180, 21, 181, 182,
+	183, 184, 185, 186, 186, 21, 187, 187,
+	187, 21, 188, 18, 189, 189, 189, 21,
+	150, 190, 190, 190, 52, 169, 191, 191,
+	191, 21, 192, 193, 194, 194, 194, 21,
+	192, 193, 195, 194, 194, 21, 192, 196,
+	193, 195, 197, 194, 194, 21, 192, 193,
+	197, 194, 194, 21, 193, 194, 194, 194,
+	21, 198, 198, 198, 59, 199, 21, 200,
+	18, 169, 21, 193, 201, 201, 201, 21,
+	106, 198, 198, 198, 21, 192, 193, 202,
+	201, 201, 21, 192, 193, 202, 201, 201,
+	201, 21, 192, 193, 201, 201, 201, 21,
+	143, 178, 178, 178, 21, 203, 18, 143,
+	163, 204, 204, 204, 52, 193, 205, 205,
+	205, 21, 192, 193, 205, 205, 205, 21,
+	206, 18, 169, 207, 207, 207, 52, 193,
+	21, 208, 18, 209, 18, 210, 211, 212,
+	184, 213, 214, 214, 215, 216, 216, 217,
+	218, 18, 192, 193, 219, 219, 219, 215,
+	192, 193, 220, 219, 219, 215, 192, 221,
+	193, 220, 222, 219, 219, 215, 192, 193,
+	222, 219, 219, 215, 193, 219, 219, 219,
+	215, 223, 18, 224, 18, 225, 18, 216,
+	216, 218, 224, 18, 106, 226, 226, 226,
+	52, 227, 18, 228, 18, 218, 18, 229,
+	229, 229, 21, 230, 230, 230, 21, 231,
+	231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 251, 252, 253, 249,
+	254, 255, 256, 255, 257, 258, 259, 260,
+	261, 262, 263, 264, 265, 266, 267, 249,
+	268, 249, 269, 270, 254, 255, 256, 255,
+	257, 258, 259, 260, 261, 262, 263, 264,
+	265, 266, 249, 271, 249, 231, 249, 249,
+	249, 272, 269, 269, 269, 269, 269, 269,
+	249, 273, 274, 0, 1, 2, 2, 2,
+	275, 276, 0, 4, 5, 5, 5, 277,
+	278, 279, 39, 276, 13, 7, 8, 9,
+	9, 9, 9, 280, 45, 281, 282, 283,
+	281, 284, 284, 284, 285, 284, 284, 286,
+	287, 281, 288, 283, 281, 29, 54, 30,
+	285, 29, 30, 286, 55, 289, 281, 281,
+	290, 280, 45, 281, 291, 283, 281, 284,
+	284, 284, 284, 284, 287, 283, 281, 29,
+	54, 30, 29, 30, 55, 292, 281, 281,
+	290, 280, 293, 45, 281, 291, 283, 281,
+	284, 284, 284, 284, 284, 287, 283, 281,
+	29, 54, 30, 29, 30, 55, 292, 294,
+	281, 281, 290, 280, 45, 281, 291, 283,
+	281, 284, 284, 284, 284, 284, 287, 283,
+	281, 29, 54, 30, 29, 30, 55, 294,
+	281, 281, 290, 295, 296, 297, 298, 299,
+	300, 276, 301, 302, 303, 304, 305, 306,
+	45, 307, 269, 308, 309, 269, 307, 269,
+	308, 310, 310, 269, 269, 310, 269, 269,
+	311, 305, 306, 45, 309, 269, 310, 310,
+	269, 310, 269, 311, 305, 306, 45, 312,
+	309, 269, 312, 310, 310, 269, 269, 310,
+	269, 269, 311, 305, 306, 45, 313, 314,
+	315, 309, 269, 313, 314, 315, 310, 310,
+	269, 269, 269, 269, 310, 269, 269, 269,
+	269, 311, 305, 306, 45, 316, 309, 269,
+	316, 310, 310, 269, 310, 269, 311, 305,
+	306, 317, 318, 319, 309, 269, 317, 318,
+	319, 269, 269, 269, 269, 269, 269, 269,
+	269, 269, 311, 305, 306, 320, 309, 269,
+	320, 269, 269, 269, 269, 269, 311, 305,
+	306, 321, 269, 322, 323, 324, 309, 269,
+	321, 269, 322, 323, 324, 269, 269, 269,
+	269, 269, 269, 269, 311, 305, 306, 309,
+	269, 269, 269, 269, 311, 305, 306, 325,
+	326, 309, 269, 325, 326, 269, 269, 269,
+	269, 269, 269, 269, 311, 305, 306, 327,
+	309, 269, 327, 269, 269, 269, 311, 305,
+	306, 328, 329, 330, 309, 269, 328, 329,
+	330, 269, 269, 269, 269, 269, 269, 269,
+	269, 269, 311, 305, 306, 331, 309, 269,
+	331, 269, 269, 269, 269, 269, 311, 305,
+	306, 332, 333, 309, 269, 332, 333, 269,
+	269, 269, 269, 269, 269, 269, 311, 32,
+	33, 34, 34, 34, 35, 334, 36, 36,
+	36, 231, 277, 277, 335, 336, 335, 39,
+	337, 7, 7, 7, 338, 339, 340, 341,
+	342, 343, 344, 342, 284, 284, 284, 284,
+	284, 287, 344, 342, 29, 54, 30, 29,
+	30, 55, 345, 342, 342, 290, 339, 341,
+	342, 342, 342, 346, 339, 341, 347, 348,
+	342, 342, 342, 349, 37, 350, 341, 351,
+	342, 342, 346, 339, 341, 347, 348, 342,
+	342, 342, 346, 56, 352, 29, 56, 352,
+	339, 280, 341, 342, 343, 344, 342, 284,
+	284, 284, 284, 284, 287, 344, 342, 29,
+	54, 30, 29, 30, 55, 353, 342, 342,
+	290, 339, 341, 342, 342, 342, 349, 339,
+	280, 341, 342, 343, 344, 342, 284, 284,
+	284, 284, 284, 287, 344, 342, 29, 54,
+	30, 29, 30, 55, 353, 345, 342, 342,
+	290, 339, 280, 341, 342, 343, 344, 342,
+	284, 284, 284, 284, 284, 287, 344, 342,
+	29, 54, 30, 29, 30, 55, 345, 342,
+	342, 290, 354, 355, 356, 357, 358, 359,
+	359, 360, 305, 306, 309, 305, 305, 305,
+	305, 361, 33, 305, 306, 309, 305, 34,
+	305, 305, 305, 361, 362, 306, 341, 309,
+	269, 363, 363, 269, 363, 269, 346, 305,
+	306, 364, 309, 269, 364, 269, 269, 269,
+	269, 269, 311, 305, 306, 365, 364, 269,
+	309, 269, 365, 364, 269, 269, 269, 269,
+	269, 269, 311, 305, 306, 366, 309, 269,
+	366, 269, 269, 269, 269, 269, 311, 305,
+	306, 367, 309, 269, 367, 269, 269, 269,
+	269, 269, 311, 305, 306, 309, 269, 269,
+	269, 269, 368, 305, 306, 369, 309, 269,
+	369, 269, 269, 269, 269, 269, 311, 362,
+	306, 341, 370, 309, 269, 370, 363, 363,
+	269, 269, 363, 269, 269, 346, 305, 306,
+	309, 269, 269, 269, 269, 371, 305, 306,
+	372, 309, 269, 372, 269, 269, 269, 269,
+	269, 311, 305, 306, 309, 269, 269, 269,
+	269, 302, 305, 306, 373, 309, 269, 373,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	374, 309, 269, 374, 269, 269, 269, 269,
+	269, 311, 305, 306, 375, 309, 269, 375,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	309, 269, 269, 269, 269, 376, 305, 306,
+	377, 309, 269, 377, 269, 269, 269, 269,
+	269, 378, 305, 306, 309, 269, 269, 269,
+	269, 379, 305, 306, 309, 269, 269, 269,
+	269, 298, 305, 306, 380, 309, 269, 380,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	309, 269, 269, 269, 269, 381, 305, 306,
+	382, 383, 309, 269, 382, 383, 269, 269,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	384, 309, 269, 384, 269, 269, 269, 269,
+	269, 311, 305, 306, 309, 269, 269, 269,
+	269, 385, 305, 306, 386, 309, 269, 386,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	387, 309, 269, 387, 269, 269, 269, 269,
+	269, 311, 388, 309, 389, 7, 7, 7,
+	385, 0, 1, 2, 2, 2, 0, 4,
+	5, 5, 5, 62, 11, 11, 11, 63,
+	63, 63, 63, 63, 390, 22, 335, 284,
+	284, 284, 284, 284, 391, 335, 29, 54,
+	30, 29, 30, 55, 43, 337, 390, 22,
+	335, 284, 284, 284, 284, 284, 391, 335,
+	29, 54, 30, 29, 30, 55, 17, 337,
+	390, 392, 22, 335, 284, 284, 284, 284,
+	284, 391, 335, 29, 54, 30, 29, 30,
+	55, 17, 14, 337, 390, 22, 335, 284,
+	284, 284, 284, 284, 391, 335, 29, 54,
+	30, 29, 30, 55, 14, 337, 340, 45,
+	46, 393, 394, 46, 284, 284, 284, 284,
+	284, 287, 394, 46, 29, 54, 30, 29,
+	30, 55, 395, 46, 46, 290, 45, 46,
+	46, 46, 349, 354, 81, 82, 83, 84,
+	85, 85, 360, 45, 347, 396, 46, 46,
+	46, 290, 45, 47, 46, 46, 337, 24,
+	25, 290, 26, 27, 27, 27, 290, 391,
+	22, 335, 284, 284, 284, 284, 284, 287,
+	335, 28, 290, 280, 45, 46, 393, 394,
+	46, 284, 284, 284, 284, 284, 287, 394,
+	46, 29, 54, 30, 29, 30, 55, 395,
+	46, 46, 290, 192, 354, 397, 398, 398,
+	398, 360, 192, 354, 397, 399, 398, 398,
+	360, 192, 354, 400, 397, 399, 401, 398,
+	398, 360, 192, 354, 397, 401, 398, 398,
+	360, 354, 397, 398, 398, 398, 360, 305,
+	306, 309, 305, 402, 402, 305, 402, 305,
+	361, 305, 306, 45, 309, 269, 403, 403,
+	269, 403, 269, 311, 86, 86, 87, 305,
+	306, 309, 269, 86, 269, 269, 269, 311,
+	305, 306, 309, 269, 269, 269, 269, 404,
+	305, 306, 405, 309, 269, 405, 269, 269,
+	269, 269, 269, 311, 305, 306, 406, 309,
+	269, 406, 269, 269, 269, 269, 269, 311,
+	305, 306, 407, 309, 269, 407, 269, 269,
+	269, 269, 269, 311, 305, 306, 387, 309,
+	269, 387, 269, 269, 269, 269, 269, 311,
+	305, 306, 269, 408, 309, 269, 269, 408,
+	269, 269, 269, 311, 305, 306, 409, 309,
+	269, 409, 269, 269, 269, 269, 269, 311,
+	305, 306, 410, 309, 269, 410, 269, 269,
+	269, 269, 269, 311, 305, 306, 309, 269,
+	269, 269, 269, 411, 305, 306, 309, 269,
+	269, 269, 269, 412, 305, 306, 413, 309,
+	269, 413, 269, 269, 269, 269, 269, 311,
+	305, 306, 364, 309, 269, 364, 269, 269,
+	269, 269, 269, 311, 305, 306, 309, 269,
+	269, 269, 269, 274, 305, 306, 414, 309,
+	269, 414, 269, 269, 269, 269, 269, 311,
+	305, 306, 415, 309, 269, 415, 269, 269,
+	269, 269, 269, 311, 305, 306, 416, 309,
+	269, 416, 269, 269, 269, 269, 269, 311,
+	38, 337, 62, 11, 11, 11, 63, 63,
+	63, 63, 63, 417, 22, 335, 284, 284,
+	284, 284, 284, 391, 335, 29, 54, 30,
+	29, 30, 55, 43, 337, 390, 22, 335,
+	284, 284, 284, 284, 284, 391, 335, 29,
+	54, 30, 29, 30, 55, 14, 43, 337,
+	418, 419, 45, 22, 335, 284, 284, 284,
+	284, 284, 287, 335, 29, 54, 30, 29,
+	30, 55, 420, 290, 71, 45, 349, 71,
+	45, 38, 337, 192, 354, 421, 422, 422,
+	422, 360, 192, 354, 421, 423, 422, 422,
+	360, 192, 354, 424, 421, 423, 425, 422,
+	422, 360, 192, 354, 421, 425, 422, 422,
+	360, 354, 421, 422, 422, 422, 360, 71,
+	45, 347, 50, 290, 347, 50, 290, 426,
+	70, 70, 337, 427, 428, 354, 397, 429,
+	429, 429, 360, 192, 354, 397, 430, 429,
+	429, 360, 192, 354, 397, 430, 429, 429,
+	429, 360, 192, 354, 397, 429, 429, 429,
+	360, 305, 306, 309, 305, 431, 431, 305,
+	431, 305, 361, 305, 432, 45, 309, 269,
+	269, 269, 269, 311, 305, 306, 433, 309,
+	269, 433, 269, 269, 269, 311, 305, 306,
+	309, 269, 269, 269, 269, 434, 305, 306,
+	435, 309, 269, 435, 269, 269, 269, 269,
+	269, 311, 305, 306, 309, 269, 269, 269,
+	269, 436, 305, 306, 437, 309, 269, 437,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	438, 309, 269, 438, 269, 269, 269, 269,
+	269, 311, 305, 306, 439, 309, 269, 439,
+	269, 269, 269, 269, 269, 311, 305, 306,
+	309, 269, 269, 269, 269, 440, 305, 306,
+	309, 269, 269, 269, 269, 441, 305, 306,
+	309, 269, 269, 269, 269, 442, 60, 388,
+	309, 60, 60, 60, 60, 389, 62, 63,
+	63, 443, 444, 134, 89, 445, 443, 444,
+	134, 68, 445, 443, 444, 134, 446, 68,
+	65, 445, 443, 444, 134, 65, 445, 22,
+	335, 284, 284, 284, 284, 284, 391, 335,
+	69, 337, 340, 22, 335, 284, 284, 284,
+	284, 284, 287, 335, 29, 54, 30, 29,
+	30, 55, 420, 290, 447, 73, 73, 73,
+	346, 354, 97, 98, 99, 100, 101, 101,
+	360, 354, 421, 448, 448, 448, 360, 192,
+	354, 421, 449, 448, 448, 360, 192, 354,
+	421, 449, 448, 448, 448, 360, 192, 354,
+	421, 448, 448, 448, 360, 90, 38, 337,
+	354, 397, 450, 450, 450, 360, 192, 354,
+	397, 450, 450, 450, 360, 451, 306, 309,
+	305, 305, 305, 305, 361, 33, 305, 306,
+	309, 305, 34, 452, 452, 305, 452, 305,
+	361, 305, 306, 453, 309, 269, 453, 269,
+	269, 269, 269, 269, 311, 305, 306, 454,
+	309, 269, 454, 269, 269, 269, 269, 269,
+	311, 305, 306, 455, 309, 269, 455, 269,
+	269, 269, 269, 269, 311, 305, 306, 456,
+	309, 269, 456, 269, 269, 269, 311, 305,
+	306, 457, 309, 269, 457, 269, 269, 269,
+	269, 269, 311, 443, 444, 89, 445, 443,
+	444, 134, 65, 89, 445, 417, 112, 458,
+	459, 112, 284, 284, 284, 284, 284, 391,
+	459, 112, 29, 54, 30, 29, 30, 55,
+	460, 112, 112, 337, 192, 354, 461, 462,
+	462, 462, 360, 192, 354, 461, 463, 462,
+	462, 360, 192, 354, 464, 461, 463, 465,
+	462, 462, 360, 192, 354, 461, 465, 462,
+	462, 360, 354, 461, 462, 462, 462, 360,
+	354, 421, 466, 466, 466, 360, 192, 354,
+	421, 466, 466, 466, 360, 354, 397, 360,
+	305, 306, 309, 305, 467, 467, 305, 467,
+	305, 361, 305, 306, 309, 305, 468, 468,
+	305, 468, 305, 361, 305, 306, 469, 309,
+	269, 469, 269, 269, 269, 269, 269, 311,
+	305, 306, 309, 269, 269, 269, 269, 470,
+	305, 306, 471, 309, 269, 471, 269, 269,
+	269, 269, 269, 311, 305, 306, 472, 309,
+	269, 472, 269, 269, 269, 269, 269, 311,
+	305, 306, 473, 309, 269, 473, 269, 269,
+	269, 269, 269, 311, 104, 443, 104, 104,
+	104, 445, 443, 105, 444, 105, 105, 105,
+	445, 354, 360, 354, 109, 360, 354, 474,
+	109, 106, 360, 354, 106, 360, 417, 123,
+	475, 476, 123, 284, 284, 284, 284, 284,
+	391, 476, 123, 29, 54, 30, 29, 30,
+	55, 477, 123, 123, 337, 123, 123, 123,
+	349, 354, 129, 130, 131, 132, 133, 133,
+	360, 354, 461, 478, 478, 478, 360, 192,
+	354, 461, 479, 478, 478, 360, 192, 354,
+	461, 479, 478, 478, 478, 360, 192, 354,
+	461, 478, 478, 478, 360, 354, 421, 360,
+	305, 306, 309, 305, 480, 480, 305, 480,
+	305, 361, 305, 306, 309, 305, 481, 481,
+	305, 481, 305, 361, 305, 306, 482, 309,
+	269, 482, 269, 269, 269, 269, 269, 311,
+	305, 306, 269, 483, 309, 269, 269, 483,
+	269, 269, 269, 311, 305, 306, 484, 309,
+	269, 484, 269, 269, 269, 269, 269, 311,
+	305, 306, 309, 269, 269, 269, 269, 485,
+	354, 106, 360, 417, 138, 486, 487, 138,
+	284, 284, 284, 284, 284, 391, 487, 138,
+	29, 54, 30, 29, 30, 55, 488, 138,
+	138, 337, 138, 138, 138, 349, 139, 138,
+	138, 337, 489, 490, 114, 114, 114, 346,
+	192, 354, 491, 492, 492, 492, 360, 192,
+	354, 491, 493, 492, 492, 360, 192, 354,
+	494, 491, 493, 495, 492, 492, 360, 192,
+	354, 491, 495, 492, 492, 360, 354, 491,
+	492, 492, 492, 360, 354, 461, 496, 496,
+	496, 360, 192, 354, 461, 496, 496, 496,
+	360, 497, 306, 309, 305, 305, 305, 305,
+	361, 305, 306, 309, 305, 498, 498, 305,
+	498, 305, 361, 305, 306, 309, 269, 269,
+	269, 269, 499, 305, 306, 309, 269, 269,
+	269, 269, 500, 305, 306, 501, 309, 269,
+	501, 269, 269, 269, 269, 269, 311, 502,
+	22, 335, 284, 284, 284, 284, 284, 391,
+	335, 29, 54, 30, 29, 30, 55, 43,
+	337, 146, 349, 146, 38, 337, 354, 152,
+	153, 154, 155, 156, 156, 360, 354, 491,
+	503, 503, 503, 360, 192, 354, 491, 504,
+	503, 503, 360, 192, 354, 491, 504, 503,
+	503, 503, 360, 192, 354, 491, 503, 503,
+	503, 360, 354, 461, 360, 143, 305, 306,
+	309, 305, 505, 505, 305, 505, 305, 361,
+	305, 506, 309, 305, 305, 305, 305, 361,
+	305, 306, 309, 269, 269, 269, 269, 507,
+	143, 508, 192, 354, 509, 510, 510, 510,
+	360, 192, 354, 509, 511, 510, 510, 360,
+	192, 354, 512, 509, 511, 513, 510, 510,
+	360, 192, 354, 509, 513, 510, 510, 360,
+	354, 509, 510, 510, 510, 360, 354, 491,
+	514, 514, 514, 360, 192, 354, 491, 514,
+	514, 514, 360, 305, 306, 309, 305, 515,
+	515, 305, 515, 305, 361, 33, 305, 306,
+	309, 305, 34, 516, 516, 305, 516, 305,
+	361, 443, 444, 517, 167, 167, 445, 489,
+	518, 140, 140, 140, 346, 354, 171, 172,
+	173, 174, 175, 175, 360, 354, 509, 519,
+	519, 519, 360, 192, 354, 509, 520, 519,
+	519, 360, 192, 354, 509, 520, 519, 519,
+	519, 360, 192, 354, 509, 519, 519, 519,
+	360, 354, 491, 360, 521, 306, 309, 305,
+	305, 305, 305, 361, 305, 306, 309, 305,
+	522, 522, 305, 522, 305, 361, 443, 444,
+	523, 178, 178, 445, 192, 354, 524, 525,
+	525, 525, 360, 192, 354, 524, 526, 525,
+	525, 360, 192, 354, 527, 524, 526, 528,
+	525, 525, 360, 192, 354, 524, 528, 525,
+	525, 360, 354, 524, 525, 525, 525, 360,
+	354, 509, 529, 529, 529, 360, 192, 354,
+	509, 529, 529, 529, 360, 143, 305, 306,
+	309, 305, 530, 530, 305, 530, 305, 361,
+	305, 306, 309, 305, 531, 531, 305, 531,
+	305, 361, 443, 444, 532, 189, 189, 445,
+	354, 198, 198, 198, 360, 354, 524, 533,
+	533, 533, 360, 192, 354, 524, 534, 533,
+	533, 360, 192, 354, 524, 534, 533, 533,
+	533, 360, 192, 354, 524, 533, 533, 533,
+	360, 354, 509, 360, 305, 306, 309, 305,
+	535, 535, 305, 535, 305, 361, 305, 306,
+	309, 305, 536, 536, 305, 536, 305, 361,
+	443, 444, 89, 537, 489, 538, 162, 162,
+	162, 346, 354, 539, 539, 539, 360, 354,
+	524, 540, 540, 540, 360, 192, 354, 524,
+	540, 540, 540, 360, 541, 306, 309, 305,
+	305, 305, 305, 361, 305, 306, 309, 305,
+	305, 305, 305, 537, 354, 542, 542, 542,
+	360, 354, 524, 360, 143, 305, 306, 309,
+	305, 531, 531, 305, 531, 305, 361, 354,
+	106, 106, 106, 360, 489, 543, 180, 180,
+	180, 537, 544, 201, 201, 201, 346, 192,
+	544, 202, 201, 201, 346, 192, 544, 202,
+	201, 201, 201, 346, 192, 544, 201, 201,
+	201, 346, 354, 545, 545, 545, 360, 354,
+	546, 542, 542, 542, 360, 546, 346,
 }
 
 var _ruleLexerImpl_trans_targs []int16 = []int16{
-	95, 0, 95, 1, 97, 2, 3, 99,
-	101, 95, 5, 95, 6, 7, 8, 105,
-	11, 105, 9, 10, 95, 107, 114, 116,
-	115, 14, 17, 19, 18, 15, 108, 110,
-	112, 111, 95, 109, 20, 22, 177, 23,
-	26, 24, 25, 27, 164, 28, 31, 29,
-	30, 32, 151, 33, 36, 34, 35, 37,
-	138, 38, 41, 39, 40, 42, 125, 43,
-	46, 44, 45, 47, 58, 61, 64, 124,
-	65, 48, 54, 57, 49, 50, 52, 51,
-	13, 53, 55, 56, 121, 59, 60, 62,
-	63, 126, 130, 133, 136, 137, 139, 143,
-	146, 149, 150, 152, 156, 159, 162, 163,
-	165, 169, 172, 175, 176, 21, 73, 190,
-	95, 75, 191, 77, 192, 79, 193, 81,
-	194, 83, 87, 88, 89, 90, 195, 196,
-	86, 198, 199, 200, 201, 71, 178, 182,
-	185, 188, 189, 93, 94, 95, 95, 96,
-	97, 98, 99, 95, 95, 100, 95, 102,
-	101, 104, 106, 203, 206, 209, 210, 224,
-	225, 226, 227, 234, 235, 242, 243, 247,
-	232, 248, 249, 250, 256, 258, 259, 95,
-	95, 261, 95, 95, 95, 95, 4, 100,
-	103, 95, 95, 12, 118, 202, 95, 95,
-	16, 95, 95, 113, 117, 119, 72, 91,
-	120, 122, 123, 127, 66, 128, 129, 131,
-	132, 134, 135, 140, 67, 141, 142, 144,
-	145, 147, 148, 153, 68, 154, 155, 157,
-	158, 160, 161, 166, 69, 167, 168, 170,
-	171, 173, 174, 179, 70, 180, 181, 183,
-	184, 186, 187, 95, 74, 76, 78, 80,
-	82, 84, 197, 85, 204, 205, 207, 208,
-	211, 212, 216, 219, 222, 223, 213, 92,
-	214, 215, 217, 218, 220, 221, 95, 95,
-	95, 95, 95, 95, 95, 228, 229, 233,
-	95, 230, 231, 232, 236, 237, 238, 239,
-	240, 241, 232, 232, 244, 245, 246, 232,
-	232, 232, 232, 232, 232, 251, 252, 253,
-	254, 255, 232, 232, 257, 232, 232, 260,
-	95,
+	175, 1, 0, 252, 3, 2, 253, 210,
+	6, 4, 22, 254, 23, 5, 255, 256,
+	257, 258, 175, 261, 29, 175, 175, 33,
+	34, 264, 35, 265, 266, 216, 13, 38,
+	17, 250, 16, 19, 18, 31, 292, 209,
+	175, 293, 44, 294, 47, 8, 45, 298,
+	49, 48, 305, 306, 175, 50, 217, 14,
+	36, 175, 307, 175, 324, 175, 41, 40,
+	325, 326, 327, 328, 329, 330, 55, 58,
+	332, 59, 333, 60, 62, 63, 64, 65,
+	66, 299, 300, 301, 302, 303, 53, 175,
+	175, 348, 70, 71, 350, 72, 74, 76,
+	75, 351, 352, 353, 354, 355, 77, 78,
+	366, 367, 368, 369, 370, 371, 79, 80,
+	81, 83, 84, 374, 85, 87, 88, 89,
+	90, 91, 92, 93, 389, 390, 95, 97,
+	96, 391, 392, 393, 394, 395, 69, 98,
+	99, 100, 101, 405, 105, 406, 106, 175,
+	108, 109, 111, 415, 112, 113, 115, 114,
+	416, 417, 418, 419, 420, 116, 117, 425,
+	118, 426, 119, 427, 120, 122, 123, 124,
+	126, 128, 127, 436, 437, 438, 439, 440,
+	129, 130, 131, 132, 133, 134, 135, 136,
+	446, 137, 138, 140, 141, 175, 455, 142,
+	51, 144, 143, 145, 146, 147, 456, 148,
+	149, 151, 152, 153, 154, 155, 156, 465,
+	157, 159, 160, 161, 162, 163, 164, 175,
+	165, 166, 175, 466, 467, 468, 469, 167,
+	168, 170, 470, 171, 172, 174, 472, 175,
+	176, 177, 175, 178, 179, 175, 175, 175,
+	175, 175, 180, 181, 182, 183, 184, 185,
+	187, 175, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 201, 202,
+	203, 204, 205, 175, 175, 200, 206, 207,
+	186, 175, 175, 175, 175, 208, 175, 175,
+	7, 212, 213, 214, 9, 10, 11, 12,
+	215, 211, 175, 219, 218, 220, 221, 222,
+	175, 175, 175, 175, 175, 175, 175, 175,
+	175, 223, 224, 226, 227, 15, 225, 175,
+	228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 242, 243,
+	244, 245, 246, 247, 248, 249, 251, 20,
+	21, 175, 175, 24, 25, 26, 27, 260,
+	28, 259, 175, 30, 262, 175, 32, 263,
+	175, 267, 37, 268, 269, 270, 271, 272,
+	175, 175, 273, 274, 275, 276, 277, 278,
+	175, 279, 280, 175, 281, 282, 283, 284,
+	175, 285, 175, 175, 286, 175, 287, 288,
+	289, 175, 290, 291, 39, 175, 42, 43,
+	295, 297, 46, 296, 304, 52, 308, 309,
+	310, 311, 312, 313, 175, 314, 315, 316,
+	317, 318, 319, 175, 175, 320, 321, 322,
+	323, 54, 56, 57, 331, 61, 334, 335,
+	336, 337, 338, 175, 175, 339, 340, 341,
+	342, 343, 175, 344, 175, 345, 346, 347,
+	175, 175, 175, 67, 68, 175, 349, 73,
+	356, 357, 358, 359, 360, 361, 362, 363,
+	364, 365, 373, 82, 372, 86, 375, 376,
+	377, 378, 379, 380, 381, 382, 175, 383,
+	384, 385, 386, 388, 94, 387, 396, 397,
+	398, 399, 400, 401, 402, 175, 404, 102,
+	403, 103, 104, 107, 407, 408, 409, 410,
+	411, 412, 413, 175, 175, 414, 110, 421,
+	422, 423, 424, 175, 175, 121, 428, 429,
+	430, 431, 432, 433, 434, 435, 125, 441,
+	442, 443, 444, 445, 139, 447, 448, 449,
+	450, 451, 452, 453, 454, 457, 458, 459,
+	460, 175, 150, 461, 462, 463, 464, 158,
+	169, 471, 173, 175, 175, 175, 175, 175,
+	175,
 }
 
-var _ruleLexerImpl_trans_actions []byte = []byte{
-	73, 0, 33, 0, 123, 0, 0, 123,
-	117, 71, 0, 37, 0, 0, 0, 132,
-	0, 75, 0, 0, 65, 117, 117, 117,
-	117, 0, 0, 0, 0, 0, 5, 5,
-	5, 5, 67, 0, 0, 0, 5, 0,
-	0, 0, 0, 0, 5, 0, 0, 0,
-	0, 0, 5, 0, 0, 0, 0, 0,
-	5, 0, 0, 0, 0, 0, 5, 0,
-	0, 0, 0, 0, 0, 0, 0, 5,
-	0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 5, 0, 0, 0,
-	0, 126, 126, 126, 126, 5, 126, 126,
-	126, 126, 5, 126, 126, 126, 126, 5,
-	126, 126, 126, 126, 5, 0, 0, 129,
-	69, 0, 129, 0, 129, 0, 129, 0,
-	129, 0, 0, 0, 0, 0, 129, 5,
-	0, 129, 129, 129, 129, 0, 126, 126,
-	126, 126, 5, 0, 0, 39, 7, 0,
-	138, 0, 138, 9, 11, 138, 17, 138,
-	138, 5, 114, 114, 114, 114, 0, 0,
-	0, 0, 135, 135, 135, 135, 135, 0,
-	135, 0, 0, 0, 0, 0, 0, 13,
-	15, 5, 43, 25, 63, 19, 0, 114,
-	0, 41, 49, 0, 114, 129, 51, 53,
-	0, 55, 35, 5, 117, 114, 0, 0,
-	114, 5, 5, 5, 0, 5, 5, 126,
-	126, 126, 126, 5, 0, 5, 5, 126,
-	126, 126, 126, 5, 0, 5, 5, 126,
-	126, 126, 126, 5, 0, 5, 5, 126,
-	126, 126, 126, 5, 0, 5, 5, 126,
-	126, 126, 126, 57, 0, 0, 0, 0,
-	0, 0, 126, 0, 114, 114, 114, 114,
-	5, 126, 126, 126, 126, 5, 5, 0,
-	5, 5, 126, 126, 126, 126, 45, 27,
-	23, 31, 47, 29, 59, 0, 129, 0,
-	61, 135, 135, 81, 0, 0, 0, 0,
-	0, 0, 105, 87, 129, 0, 0, 120,
-	102, 99, 111, 96, 93, 0, 0, 0,
-	0, 0, 108, 90, 0, 78, 84, 0,
-	21,
+var _ruleLexerImpl_trans_actions []int16 = []int16{
+	43, 0, 0, 274, 0, 0, 274, 292,
+	0, 0, 0, 292, 0, 0, 262, 262,
+	262, 262, 175, 286, 0, 191, 41, 0,
+	0, 259, 0, 259, 259, 277, 0, 0,
+	0, 301, 0, 0, 0, 0, 262, 262,
+	177, 193, 0, 262, 0, 0, 0, 262,
+	0, 0, 259, 262, 185, 0, 277, 0,
+	0, 179, 5, 183, 301, 189, 0, 0,
+	193, 283, 283, 283, 283, 262, 0, 0,
+	289, 0, 286, 0, 0, 0, 0, 0,
+	0, 286, 286, 286, 286, 286, 0, 53,
+	187, 283, 0, 0, 262, 0, 0, 0,
+	0, 286, 286, 286, 286, 286, 0, 0,
+	283, 283, 286, 286, 286, 286, 0, 0,
+	0, 0, 0, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 289, 0, 0,
+	0, 286, 286, 286, 286, 286, 0, 0,
+	0, 0, 0, 262, 0, 286, 0, 49,
+	0, 0, 0, 5, 0, 0, 0, 0,
+	286, 286, 286, 286, 286, 0, 0, 283,
+	0, 289, 0, 286, 0, 0, 0, 0,
+	0, 0, 0, 286, 286, 286, 286, 286,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	286, 0, 0, 0, 0, 47, 289, 0,
+	0, 0, 0, 0, 0, 0, 286, 0,
+	0, 0, 0, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 0, 0, 181,
+	0, 0, 45, 289, 289, 289, 289, 0,
+	0, 0, 286, 0, 0, 0, 289, 7,
+	196, 304, 37, 304, 304, 9, 11, 35,
+	33, 17, 5, 304, 5, 259, 259, 259,
+	5, 55, 211, 304, 217, 5, 295, 295,
+	295, 295, 295, 295, 295, 295, 295, 295,
+	295, 295, 295, 13, 15, 295, 304, 5,
+	259, 23, 69, 19, 169, 193, 119, 39,
+	0, 289, 265, 289, 0, 0, 0, 0,
+	289, 259, 131, 265, 259, 259, 259, 286,
+	109, 25, 79, 21, 29, 27, 83, 31,
+	105, 298, 298, 295, 295, 0, 289, 163,
+	295, 295, 205, 295, 289, 220, 295, 217,
+	295, 295, 295, 250, 238, 214, 211, 295,
+	208, 295, 295, 202, 295, 295, 202, 0,
+	0, 133, 159, 0, 0, 0, 0, 265,
+	0, 259, 157, 0, 259, 135, 0, 262,
+	143, 259, 0, 286, 286, 286, 286, 286,
+	153, 165, 298, 295, 295, 199, 295, 295,
+	75, 295, 295, 85, 295, 295, 295, 226,
+	111, 229, 97, 81, 295, 77, 295, 196,
+	295, 73, 295, 295, 0, 167, 0, 0,
+	262, 265, 0, 259, 259, 0, 286, 286,
+	286, 286, 298, 295, 71, 295, 256, 295,
+	241, 295, 295, 89, 91, 295, 271, 253,
+	268, 0, 0, 0, 259, 0, 286, 286,
+	286, 286, 262, 51, 155, 286, 286, 298,
+	298, 295, 115, 295, 99, 295, 295, 295,
+	139, 113, 137, 0, 0, 151, 283, 0,
+	286, 286, 286, 298, 298, 295, 247, 295,
+	295, 295, 265, 0, 262, 0, 286, 286,
+	286, 286, 286, 298, 298, 295, 103, 295,
+	295, 235, 286, 265, 0, 262, 286, 286,
+	298, 298, 223, 244, 295, 95, 265, 0,
+	262, 0, 0, 0, 286, 286, 286, 286,
+	286, 298, 298, 87, 101, 232, 0, 286,
+	286, 298, 298, 93, 149, 0, 286, 286,
+	286, 286, 286, 298, 298, 283, 0, 286,
+	286, 298, 298, 283, 0, 286, 286, 286,
+	286, 286, 298, 298, 280, 286, 286, 298,
+	280, 147, 0, 286, 286, 298, 286, 0,
+	0, 286, 0, 171, 173, 123, 129, 57,
+	141,
 }
 
 var _ruleLexerImpl_to_state_actions []byte = []byte{
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
@@ -734,10 +1473,37 @@ var _ruleLexerImpl_to_state_actions []byte = []byte{
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0,
 }
 
 var _ruleLexerImpl_from_state_actions []byte = []byte{
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
@@ -770,70 +1536,117 @@ var _ruleLexerImpl_from_state_actions []byte = []byte{
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0,
 }
 
 var _ruleLexerImpl_eof_trans []int16 = []int16{
-	1, 1, 1, 1, 1, 10, 10, 10,
-	10, 1, 1, 10, 21, 1, 1, 1,
-	35, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 35, 35, 35, 35, 35, 1,
-	1, 1, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 1, 1, 113,
-	113, 113, 113, 1, 35, 10, 10, 0,
-	179, 1, 181, 1, 1, 1, 181, 186,
-	181, 1, 187, 191, 192, 194, 192, 192,
-	192, 192, 191, 191, 191, 191, 187, 187,
-	187, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 244, 244,
-	244, 244, 244, 244, 192, 192, 244, 244,
-	244, 244, 244, 187, 187, 187, 187, 187,
-	187, 187, 181, 192, 192, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192,
-	271, 181, 275, 277, 281, 244, 277, 277,
-	1, 277, 277, 277, 277, 277, 277, 277,
-	277, 277, 277, 277, 244, 277, 277, 277,
-	277, 277, 277, 277, 277, 277, 277, 277,
-	277, 277, 277, 277, 277, 181,
+	22, 22, 22, 22, 548, 548, 548, 19,
+	22, 22, 19, 19, 19, 22, 22, 22,
+	22, 22, 549, 549, 22, 41, 548, 548,
+	22, 19, 22, 22, 19, 22, 22, 22,
+	53, 22, 19, 19, 58, 60, 22, 62,
+	22, 22, 41, 22, 22, 22, 19, 22,
+	22, 22, 58, 22, 60, 89, 41, 22,
+	19, 19, 22, 22, 22, 60, 58, 22,
+	22, 22, 22, 22, 22, 22, 22, 19,
+	22, 53, 22, 22, 22, 22, 22, 22,
+	19, 22, 41, 53, 22, 22, 60, 22,
+	22, 22, 22, 22, 19, 22, 41, 22,
+	22, 22, 22, 22, 19, 22, 41, 22,
+	53, 22, 22, 60, 22, 19, 41, 22,
+	53, 22, 22, 22, 22, 19, 22, 22,
+	22, 60, 22, 19, 22, 53, 22, 22,
+	22, 22, 19, 22, 53, 22, 22, 22,
+	22, 22, 22, 60, 22, 19, 22, 22,
+	22, 22, 22, 22, 22, 19, 53, 22,
+	22, 19, 53, 22, 19, 19, 216, 19,
+	216, 216, 216, 216, 216, 19, 19, 19,
+	19, 53, 19, 19, 19, 22, 22, 0,
+	275, 277, 277, 277, 279, 277, 550, 291,
+	291, 291, 291, 297, 299, 277, 303, 305,
+	312, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 312, 312, 277, 551,
+	552, 338, 339, 291, 347, 350, 347, 347,
+	353, 353, 291, 350, 291, 291, 361, 362,
+	362, 347, 312, 312, 312, 312, 369, 312,
+	347, 372, 312, 303, 312, 312, 312, 377,
+	379, 380, 299, 312, 382, 312, 312, 386,
+	312, 312, 390, 386, 553, 553, 339, 338,
+	338, 338, 338, 291, 350, 361, 291, 338,
+	291, 291, 291, 291, 361, 361, 361, 361,
+	361, 362, 312, 312, 405, 312, 312, 312,
+	312, 312, 312, 312, 412, 413, 312, 312,
+	275, 312, 312, 312, 338, 552, 338, 338,
+	291, 350, 338, 361, 361, 361, 361, 361,
+	291, 291, 338, 429, 361, 361, 361, 361,
+	362, 312, 312, 435, 312, 437, 312, 312,
+	312, 441, 442, 443, 390, 552, 446, 446,
+	446, 446, 338, 291, 347, 361, 361, 361,
+	361, 361, 338, 361, 361, 362, 362, 312,
+	312, 312, 312, 312, 446, 446, 338, 361,
+	361, 361, 361, 361, 361, 361, 361, 362,
+	362, 312, 471, 312, 312, 312, 446, 446,
+	361, 361, 361, 361, 338, 350, 361, 361,
+	361, 361, 361, 361, 362, 362, 312, 312,
+	312, 486, 361, 338, 350, 338, 347, 361,
+	361, 361, 361, 361, 361, 361, 362, 362,
+	500, 501, 312, 338, 350, 338, 361, 361,
+	361, 361, 361, 361, 362, 362, 508, 509,
+	361, 361, 361, 361, 361, 361, 361, 362,
+	362, 446, 347, 361, 361, 361, 361, 361,
+	361, 362, 362, 446, 361, 361, 361, 361,
+	361, 361, 361, 362, 362, 446, 361, 361,
+	361, 361, 361, 361, 362, 362, 538, 347,
+	361, 361, 361, 362, 538, 361, 361, 362,
+	361, 538, 347, 347, 347, 347, 361, 361,
+	347,
 }
 
-const ruleLexerImpl_start int = 95
-const ruleLexerImpl_first_final int = 95
+const ruleLexerImpl_start int = 175
+const ruleLexerImpl_first_final int = 175
 const ruleLexerImpl_error int = -1
 
-const ruleLexerImpl_en_main int = 95
+const ruleLexerImpl_en_main int = 175
 
-//line lexer.rl:140
+//line lexer.rl:220
 
 type ruleLexerImpl struct {
-	data   []byte
-	cs     int
-	p      int
-	pe     int
-	act    int
-	ts     int
-	te     int
-	eof    int
-	result Rule
-	err    string
+	data []byte
+	cs   int
+	p    int
+	pe   int
+	act  int
+	ts   int
+	te   int
+	eof  int
+	prev int // the previously returned token kind
+	// emit op_CONV_AND and op_CONV_OR, which give `and` a higher precedence than `or`
+	conventionalPrecedence bool
+	result                 Rule
+	err                    string
 }
 
 func newLex(line []byte) *ruleLexerImpl {
 	lexer := ruleLexerImpl{data: line}
 
-//line lexer.go:839
+//line lexer.go:1651
 	{
 		(lexer.cs) = ruleLexerImpl_start
 		(lexer.ts) = 0
@@ -841,7 +1654,7 @@ func newLex(line []byte) *ruleLexerImpl {
 		(lexer.act) = 0
 	}
 
-//line lexer.rl:158
+//line lexer.rl:241
 	lexer.pe = len(line)
 	lexer.eof = len(line)
 	return &lexer
@@ -850,7 +1663,7 @@ func newLex(line []byte) *ruleLexerImpl {
 func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 	token_kind := 0
 
-//line lexer.go:856
+//line lexer.go:1668
 	{
 		var _klen int
 		var _trans int
@@ -871,7 +1684,7 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 //line NONE:1
 				(lexer.ts) = (lexer.p)
 
-//line lexer.go:876
+//line lexer.go:1690
 			}
 		}
 
@@ -947,211 +1760,368 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 				(lexer.te) = (lexer.p) + 1
 
 			case 3:
-//line lexer.rl:81
+//line lexer.rl:119
 				(lexer.act) = 1
 			case 4:
-//line lexer.rl:91
+//line lexer.rl:129
 				(lexer.act) = 7
 			case 5:
-//line lexer.rl:92
+//line lexer.rl:130
 				(lexer.act) = 8
 			case 6:
-//line lexer.rl:93
+//line lexer.rl:131
 				(lexer.act) = 9
 			case 7:
-//line lexer.rl:96
+//line lexer.rl:134
 				(lexer.act) = 10
 			case 8:
-//line lexer.rl:97
+//line lexer.rl:135
 				(lexer.act) = 11
 			case 9:
-//line lexer.rl:98
+//line lexer.rl:136
 				(lexer.act) = 12
 			case 10:
-//line lexer.rl:99
+//line lexer.rl:137
 				(lexer.act) = 13
 			case 11:
-//line lexer.rl:100
+//line lexer.rl:138
 				(lexer.act) = 14
 			case 12:
-//line lexer.rl:101
+//line lexer.rl:139
 				(lexer.act) = 15
 			case 13:
-//line lexer.rl:103
+//line lexer.rl:141
 				(lexer.act) = 16
 			case 14:
-//line lexer.rl:104
+//line lexer.rl:144
 				(lexer.act) = 17
 			case 15:
-//line lexer.rl:105
+//line lexer.rl:145
 				(lexer.act) = 18
 			case 16:
-//line lexer.rl:108
+//line lexer.rl:146
 				(lexer.act) = 19
 			case 17:
-//line lexer.rl:109
+//line lexer.rl:148
 				(lexer.act) = 20
 			case 18:
-//line lexer.rl:110
+//line lexer.rl:149
 				(lexer.act) = 21
 			case 19:
-//line lexer.rl:111
+//line lexer.rl:150
 				(lexer.act) = 22
 			case 20:
-//line lexer.rl:113
+//line lexer.rl:151
 				(lexer.act) = 23
 			case 21:
-//line lexer.rl:115
-				(lexer.act) = 25
+//line lexer.rl:152
+				(lexer.act) = 24
 			case 22:
-//line lexer.rl:116
-				(lexer.act) = 26
+//line lexer.rl:158
+				(lexer.act) = 28
 			case 23:
-//line lexer.rl:118
-				(lexer.act) = 27
-			case 24:
-//line lexer.rl:124
+//line lexer.rl:159
 				(lexer.act) = 29
+			case 24:
+//line lexer.rl:160
+				(lexer.act) = 30
 			case 25:
-//line lexer.rl:81
+//line lexer.rl:176
+				(lexer.act) = 38
+			case 26:
+//line lexer.rl:177
+				(lexer.act) = 39
+			case 27:
+//line lexer.rl:178
+				(lexer.act) = 40
+			case 28:
+//line lexer.rl:179
+				(lexer.act) = 41
+			case 29:
+//line lexer.rl:180
+				(lexer.act) = 42
+			case 30:
+//line lexer.rl:181
+				(lexer.act) = 43
+			case 31:
+//line lexer.rl:183
+				(lexer.act) = 44
+			case 32:
+//line lexer.rl:185
+				(lexer.act) = 46
+			case 33:
+//line lexer.rl:187
+				(lexer.act) = 48
+			case 34:
+//line lexer.rl:189
+				(lexer.act) = 49
+			case 35:
+//line lexer.rl:191
+				(lexer.act) = 51
+			case 36:
+//line lexer.rl:192
+				(lexer.act) = 52
+			case 37:
+//line lexer.rl:197
+				(lexer.act) = 54
+			case 38:
+//line lexer.rl:200
+				(lexer.act) = 55
+			case 39:
+//line lexer.rl:201
+				(lexer.act) = 56
+			case 40:
+//line lexer.rl:204
+				(lexer.act) = 57
+			case 41:
+//line lexer.rl:119
 				(lexer.te) = (lexer.p) + 1
 				{ /* skip */
 				}
-			case 26:
-//line lexer.rl:84
+			case 42:
+//line lexer.rl:122
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = token_LPAREN
 					(lexer.p)++
 					goto _out
 				}
-			case 27:
-//line lexer.rl:85
+			case 43:
+//line lexer.rl:123
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = token_RPAREN
 					(lexer.p)++
 					goto _out
 				}
-			case 28:
-//line lexer.rl:86
+			case 44:
+//line lexer.rl:124
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = token_LBRACKET
 					(lexer.p)++
 					goto _out
 				}
-			case 29:
-//line lexer.rl:87
+			case 45:
+//line lexer.rl:125
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = token_RBRACKET
 					(lexer.p)++
 					goto _out
 				}
-			case 30:
-//line lexer.rl:88
+			case 46:
+//line lexer.rl:126
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = token_COMMA
 					(lexer.p)++
 					goto _out
 				}
-			case 31:
-//line lexer.rl:92
+			case 47:
+//line lexer.rl:130
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = op_AND
 					(lexer.p)++
 					goto _out
 				}
-			case 32:
-//line lexer.rl:93
-				(lexer.te) = (lexer.p) + 1
-				{
-					token_kind = op_OR
-					(lexer.p)++
-					goto _out
-				}
-			case 33:
-//line lexer.rl:96
+			case 48:
+//line lexer.rl:134
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = op_EQ
 					(lexer.p)++
 					goto _out
 				}
-			case 34:
-//line lexer.rl:97
+			case 49:
+//line lexer.rl:135
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = op_NE
 					(lexer.p)++
 					goto _out
 				}
-			case 35:
-//line lexer.rl:99
+			case 50:
+//line lexer.rl:137
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = op_LE
 					(lexer.p)++
 					goto _out
 				}
-			case 36:
-//line lexer.rl:101
+			case 51:
+//line lexer.rl:139
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = op_GE
 					(lexer.p)++
 					goto _out
 				}
-			case 37:
-//line lexer.rl:104
+			case 52:
+//line lexer.rl:148
 				(lexer.te) = (lexer.p) + 1
 				{
 					token_kind = op_MATCHES
 					(lexer.p)++
 					goto _out
 				}
-			case 38:
-//line lexer.rl:111
+			case 53:
+//line lexer.rl:156
 				(lexer.te) = (lexer.p) + 1
 				{
-					token_kind = token_STRING
+					token_kind = op_COALESCE
 					(lexer.p)++
 					goto _out
 				}
-			case 39:
-//line lexer.rl:114
+			case 54:
+//line lexer.rl:163
 				(lexer.te) = (lexer.p) + 1
 				{
-					token_kind = token_IP_CIDR
+					token_kind = op_ADD
 					(lexer.p)++
 					goto _out
 				}
-			case 40:
-//line lexer.rl:116
+			case 55:
+//line lexer.rl:165
 				(lexer.te) = (lexer.p) + 1
 				{
-					token_kind = token_REGEX
+					token_kind = op_MUL
 					(lexer.p)++
 					goto _out
 				}
-			case 41:
-//line lexer.rl:124
+			case 56:
+//line lexer.rl:167
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = op_MOD
+					(lexer.p)++
+					goto _out
+				}
+			case 57:
+//line lexer.rl:170
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = op_RANGE
+					(lexer.p)++
+					goto _out
+				}
+			case 58:
+//line lexer.rl:178
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_BYTES
+					(lexer.p)++
+					goto _out
+				}
+			case 59:
+//line lexer.rl:181
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_STRING
+					(lexer.p)++
+					goto _out
+				}
+			case 60:
+//line lexer.rl:184
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_TIMESTAMP
+					(lexer.p)++
+					goto _out
+				}
+			case 61:
+//line lexer.rl:185
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_MAC
+					(lexer.p)++
+					goto _out
+				}
+			case 62:
+//line lexer.rl:186
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_MAC_PREFIX
+					(lexer.p)++
+					goto _out
+				}
+			case 63:
+//line lexer.rl:190
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_IP_CIDR
+					(lexer.p)++
+					goto _out
+				}
+			case 64:
+//line lexer.rl:195
+				(lexer.te) = (lexer.p) + 1
+				{
+					token_kind = token_QUANTIFIER
+					(lexer.p)++
+					goto _out
+				}
+			case 65:
+//line lexer.rl:204
 				(lexer.te) = (lexer.p) + 1
 				{
 					lexer.Error(fmt.Sprintf("unexpected character: %q", safeIndex(lexer.data, lexer.ts, lexer.te)))
 					return token_ERROR
 				}
-			case 42:
-//line lexer.rl:81
+			case 66:
+//line lexer.rl:119
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{ /* skip */
 				}
-			case 43:
-//line lexer.rl:91
+			case 67:
+//line lexer.rl:122
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_LPAREN
+					(lexer.p)++
+					goto _out
+				}
+			case 68:
+//line lexer.rl:123
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_RPAREN
+					(lexer.p)++
+					goto _out
+				}
+			case 69:
+//line lexer.rl:124
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_LBRACKET
+					(lexer.p)++
+					goto _out
+				}
+			case 70:
+//line lexer.rl:125
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_RBRACKET
+					(lexer.p)++
+					goto _out
+				}
+			case 71:
+//line lexer.rl:126
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_COMMA
+					(lexer.p)++
+					goto _out
+				}
+			case 72:
+//line lexer.rl:129
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1159,8 +2129,44 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 44:
-//line lexer.rl:98
+			case 73:
+//line lexer.rl:130
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_AND
+					(lexer.p)++
+					goto _out
+				}
+			case 74:
+//line lexer.rl:131
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_OR
+					(lexer.p)++
+					goto _out
+				}
+			case 75:
+//line lexer.rl:134
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_EQ
+					(lexer.p)++
+					goto _out
+				}
+			case 76:
+//line lexer.rl:135
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_NE
+					(lexer.p)++
+					goto _out
+				}
+			case 77:
+//line lexer.rl:136
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1168,8 +2174,17 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 45:
-//line lexer.rl:100
+			case 78:
+//line lexer.rl:137
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_LE
+					(lexer.p)++
+					goto _out
+				}
+			case 79:
+//line lexer.rl:138
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1177,8 +2192,215 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 46:
-//line lexer.rl:108
+			case 80:
+//line lexer.rl:139
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_GE
+					(lexer.p)++
+					goto _out
+				}
+			case 81:
+//line lexer.rl:141
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_CONTAINS
+					(lexer.p)++
+					goto _out
+				}
+			case 82:
+//line lexer.rl:144
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_IEQ
+					(lexer.p)++
+					goto _out
+				}
+			case 83:
+//line lexer.rl:145
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_INE
+					(lexer.p)++
+					goto _out
+				}
+			case 84:
+//line lexer.rl:146
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_ICONTAINS
+					(lexer.p)++
+					goto _out
+				}
+			case 85:
+//line lexer.rl:148
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_MATCHES
+					(lexer.p)++
+					goto _out
+				}
+			case 86:
+//line lexer.rl:149
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_IN
+					(lexer.p)++
+					goto _out
+				}
+			case 87:
+//line lexer.rl:150
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_GLOB
+					(lexer.p)++
+					goto _out
+				}
+			case 88:
+//line lexer.rl:151
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_HOSTGLOB
+					(lexer.p)++
+					goto _out
+				}
+			case 89:
+//line lexer.rl:152
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_EXISTS
+					(lexer.p)++
+					goto _out
+				}
+			case 90:
+//line lexer.rl:155
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_QUESTION
+					(lexer.p)++
+					goto _out
+				}
+			case 91:
+//line lexer.rl:156
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_COALESCE
+					(lexer.p)++
+					goto _out
+				}
+			case 92:
+//line lexer.rl:157
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_COLON
+					(lexer.p)++
+					goto _out
+				}
+			case 93:
+//line lexer.rl:158
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_IF
+					(lexer.p)++
+					goto _out
+				}
+			case 94:
+//line lexer.rl:159
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_THEN
+					(lexer.p)++
+					goto _out
+				}
+			case 95:
+//line lexer.rl:160
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_ELSE
+					(lexer.p)++
+					goto _out
+				}
+			case 96:
+//line lexer.rl:163
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_ADD
+					(lexer.p)++
+					goto _out
+				}
+			case 97:
+//line lexer.rl:164
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_SUB
+					(lexer.p)++
+					goto _out
+				}
+			case 98:
+//line lexer.rl:165
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_MUL
+					(lexer.p)++
+					goto _out
+				}
+			case 99:
+//line lexer.rl:166
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_DIV
+					(lexer.p)++
+					goto _out
+				}
+			case 100:
+//line lexer.rl:167
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_MOD
+					(lexer.p)++
+					goto _out
+				}
+			case 101:
+//line lexer.rl:170
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_RANGE
+					(lexer.p)++
+					goto _out
+				}
+			case 102:
+//line lexer.rl:173
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = op_PIPE
+					(lexer.p)++
+					goto _out
+				}
+			case 103:
+//line lexer.rl:176
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1186,8 +2408,8 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 47:
-//line lexer.rl:109
+			case 104:
+//line lexer.rl:177
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1195,8 +2417,89 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 48:
-//line lexer.rl:113
+			case 105:
+//line lexer.rl:178
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_BYTES
+					(lexer.p)++
+					goto _out
+				}
+			case 106:
+//line lexer.rl:179
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_BOOL
+					(lexer.p)++
+					goto _out
+				}
+			case 107:
+//line lexer.rl:180
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_NULL
+					(lexer.p)++
+					goto _out
+				}
+			case 108:
+//line lexer.rl:181
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_STRING
+					(lexer.p)++
+					goto _out
+				}
+			case 109:
+//line lexer.rl:183
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_DURATION
+					(lexer.p)++
+					goto _out
+				}
+			case 110:
+//line lexer.rl:184
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_TIMESTAMP
+					(lexer.p)++
+					goto _out
+				}
+			case 111:
+//line lexer.rl:185
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_MAC
+					(lexer.p)++
+					goto _out
+				}
+			case 112:
+//line lexer.rl:186
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_MAC_PREFIX
+					(lexer.p)++
+					goto _out
+				}
+			case 113:
+//line lexer.rl:187
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_SEMVER
+					(lexer.p)++
+					goto _out
+				}
+			case 114:
+//line lexer.rl:189
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1204,8 +2507,8 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 49:
-//line lexer.rl:114
+			case 115:
+//line lexer.rl:190
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1213,8 +2516,8 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 50:
-//line lexer.rl:115
+			case 116:
+//line lexer.rl:191
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1222,8 +2525,26 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 51:
-//line lexer.rl:118
+			case 117:
+//line lexer.rl:192
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_REGEX
+					(lexer.p)++
+					goto _out
+				}
+			case 118:
+//line lexer.rl:195
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_QUANTIFIER
+					(lexer.p)++
+					goto _out
+				}
+			case 119:
+//line lexer.rl:197
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1231,8 +2552,8 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 52:
-//line lexer.rl:121
+			case 120:
+//line lexer.rl:200
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
@@ -1240,46 +2561,104 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					(lexer.p)++
 					goto _out
 				}
-			case 53:
-//line lexer.rl:124
+			case 121:
+//line lexer.rl:201
+				(lexer.te) = (lexer.p)
+				(lexer.p)--
+				{
+					token_kind = token_FIELD_PATH
+					(lexer.p)++
+					goto _out
+				}
+			case 122:
+//line lexer.rl:204
 				(lexer.te) = (lexer.p)
 				(lexer.p)--
 				{
 					lexer.Error(fmt.Sprintf("unexpected character: %q", safeIndex(lexer.data, lexer.ts, lexer.te)))
 					return token_ERROR
 				}
-			case 54:
-//line lexer.rl:108
+			case 123:
+//line lexer.rl:166
+				(lexer.p) = (lexer.te) - 1
+				{
+					token_kind = op_DIV
+					(lexer.p)++
+					goto _out
+				}
+			case 124:
+//line lexer.rl:173
+				(lexer.p) = (lexer.te) - 1
+				{
+					token_kind = op_PIPE
+					(lexer.p)++
+					goto _out
+				}
+			case 125:
+//line lexer.rl:176
 				(lexer.p) = (lexer.te) - 1
 				{
 					token_kind = token_INT
 					(lexer.p)++
 					goto _out
 				}
-			case 55:
-//line lexer.rl:113
+			case 126:
+//line lexer.rl:177
+				(lexer.p) = (lexer.te) - 1
+				{
+					token_kind = token_FLOAT
+					(lexer.p)++
+					goto _out
+				}
+			case 127:
+//line lexer.rl:183
+				(lexer.p) = (lexer.te) - 1
+				{
+					token_kind = token_DURATION
+					(lexer.p)++
+					goto _out
+				}
+			case 128:
+//line lexer.rl:185
+				(lexer.p) = (lexer.te) - 1
+				{
+					token_kind = token_MAC
+					(lexer.p)++
+					goto _out
+				}
+			case 129:
+//line lexer.rl:189
 				(lexer.p) = (lexer.te) - 1
 				{
 					token_kind = token_IP
 					(lexer.p)++
 					goto _out
 				}
-			case 56:
-//line lexer.rl:115
+			case 130:
+//line lexer.rl:191
 				(lexer.p) = (lexer.te) - 1
 				{
 					token_kind = token_HEX_STRING
 					(lexer.p)++
 					goto _out
 				}
-			case 57:
-//line lexer.rl:124
+			case 131:
+//line lexer.rl:197
 				(lexer.p) = (lexer.te) - 1
 				{
-					lexer.Error(fmt.Sprintf("unexpected character: %q", safeIndex(lexer.data, lexer.ts, lexer.te)))
-					return token_ERROR
+					token_kind = token_FUNCTION
+					(lexer.p)++
+					goto _out
 				}
-			case 58:
+			case 132:
+//line lexer.rl:201
+				(lexer.p) = (lexer.te) - 1
+				{
+					token_kind = token_FIELD_PATH
+					(lexer.p)++
+					goto _out
+				}
+			case 133:
 //line NONE:1
 				switch lexer.act {
 				case 1:
@@ -1360,74 +2739,186 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 				case 17:
 					{
 						(lexer.p) = (lexer.te) - 1
-						token_kind = op_MATCHES
+						token_kind = op_IEQ
 						(lexer.p)++
 						goto _out
 					}
 				case 18:
 					{
 						(lexer.p) = (lexer.te) - 1
-						token_kind = op_IN
+						token_kind = op_INE
 						(lexer.p)++
 						goto _out
 					}
 				case 19:
 					{
 						(lexer.p) = (lexer.te) - 1
-						token_kind = token_INT
+						token_kind = op_ICONTAINS
 						(lexer.p)++
 						goto _out
 					}
 				case 20:
 					{
 						(lexer.p) = (lexer.te) - 1
-						token_kind = token_FLOAT
+						token_kind = op_MATCHES
 						(lexer.p)++
 						goto _out
 					}
 				case 21:
 					{
 						(lexer.p) = (lexer.te) - 1
-						token_kind = token_BOOL
+						token_kind = op_IN
 						(lexer.p)++
 						goto _out
 					}
 				case 22:
 					{
 						(lexer.p) = (lexer.te) - 1
-						token_kind = token_STRING
+						token_kind = op_GLOB
 						(lexer.p)++
 						goto _out
 					}
 				case 23:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = op_HOSTGLOB
+						(lexer.p)++
+						goto _out
+					}
+				case 24:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = op_EXISTS
+						(lexer.p)++
+						goto _out
+					}
+				case 28:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = op_IF
+						(lexer.p)++
+						goto _out
+					}
+				case 29:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = op_THEN
+						(lexer.p)++
+						goto _out
+					}
+				case 30:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = op_ELSE
+						(lexer.p)++
+						goto _out
+					}
+				case 38:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_INT
+						(lexer.p)++
+						goto _out
+					}
+				case 39:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_FLOAT
+						(lexer.p)++
+						goto _out
+					}
+				case 40:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_BYTES
+						(lexer.p)++
+						goto _out
+					}
+				case 41:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_BOOL
+						(lexer.p)++
+						goto _out
+					}
+				case 42:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_NULL
+						(lexer.p)++
+						goto _out
+					}
+				case 43:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_STRING
+						(lexer.p)++
+						goto _out
+					}
+				case 44:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_DURATION
+						(lexer.p)++
+						goto _out
+					}
+				case 46:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_MAC
+						(lexer.p)++
+						goto _out
+					}
+				case 48:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_SEMVER
+						(lexer.p)++
+						goto _out
+					}
+				case 49:
 					{
 						(lexer.p) = (lexer.te) - 1
 						token_kind = token_IP
 						(lexer.p)++
 						goto _out
 					}
-				case 25:
+				case 51:
 					{
 						(lexer.p) = (lexer.te) - 1
 						token_kind = token_HEX_STRING
 						(lexer.p)++
 						goto _out
 					}
-				case 26:
+				case 52:
 					{
 						(lexer.p) = (lexer.te) - 1
 						token_kind = token_REGEX
 						(lexer.p)++
 						goto _out
 					}
-				case 27:
+				case 54:
 					{
 						(lexer.p) = (lexer.te) - 1
 						token_kind = token_FUNCTION
 						(lexer.p)++
 						goto _out
 					}
-				case 29:
+				case 55:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_FIELD
+						(lexer.p)++
+						goto _out
+					}
+				case 56:
+					{
+						(lexer.p) = (lexer.te) - 1
+						token_kind = token_FIELD_PATH
+						(lexer.p)++
+						goto _out
+					}
+				case 57:
 					{
 						(lexer.p) = (lexer.te) - 1
 
@@ -1436,7 +2927,7 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 					}
 				}
 
-//line lexer.go:1291
+//line lexer.go:2433
 			}
 		}
 
@@ -1451,7 +2942,7 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 //line NONE:1
 				(lexer.ts) = 0
 
-//line lexer.go:1305
+//line lexer.go:2449
 			}
 		}
 
@@ -1474,7 +2965,28 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 		}
 	}
 
-//line lexer.rl:166
+//line lexer.rl:249
+	if token_kind == token_REGEX && lexer.data[lexer.ts] == '/' && endsOperand(lexer.prev) {
+		// a '/' following an operand is the division operator rather than the start of a regex,
+		// e.g. `a / b > c / d`. rewind to just after the slash.
+		lexer.te = lexer.ts + 1
+		lexer.p = lexer.te
+		token_kind = op_DIV
+	} else if token_kind == token_REGEX && lexer.data[lexer.ts] == '|' && endsOperand(lexer.prev) {
+		// likewise a '|' following an operand is a pipe, e.g. `url | lower | trim`
+		lexer.te = lexer.ts + 1
+		lexer.p = lexer.te
+		token_kind = op_PIPE
+	}
+	if lexer.conventionalPrecedence {
+		switch token_kind {
+		case op_AND:
+			token_kind = op_CONV_AND
+		case op_OR:
+			token_kind = op_CONV_OR
+		}
+	}
+	lexer.prev = token_kind
 	if lexer.cs != ruleLexerImpl_error {
 		lval.valueLiteral = safeIndex(lexer.data, lexer.ts, lexer.te)
 	}
@@ -1485,6 +2997,16 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 	return token_kind
 }
 
+// endsOperand reports whether a token of the given kind may end an operand.
+func endsOperand(token_kind int) bool {
+	switch token_kind {
+	case token_FIELD, token_FIELD_PATH, token_FUNCTION, token_STRING, token_HEX_STRING, token_INT, token_FLOAT, token_BYTES,
+		token_BOOL, token_IP_CIDR, token_IP, token_REGEX, token_DURATION, token_TIMESTAMP, token_SEMVER, token_MAC, token_MAC_PREFIX, token_NULL, token_RPAREN, token_RBRACKET:
+		return true
+	}
+	return false
+}
+
 func (lexer *ruleLexerImpl) Error(s string) {
 	lexer.err = s
 }
@@ -10,8 +10,18 @@ import (
 	# Basic types
 	# ---
 
-	int    = ('-' | '+')? digit+;
-	float  = ('-' | '+')? digit* '.' digit+;
+	# signs are lexed as separate operators and folded into the literal by the parser
+	# digits may be separated by underscores, e.g. 1_000_000
+	dec_int  = digit ('_'? digit)*;
+	hex_int  = '0' [xX] ('_'? xdigit)+;
+	oct_int  = '0' [oO] ('_'? [0-7])+;
+	bin_int  = '0' [bB] ('_'? [01])+;
+	int      = dec_int | hex_int | oct_int | bin_int;
+	exponent = [eE] [+\-]? digit+;
+	float    = (dec_int? '.' dec_int exponent?) | (dec_int exponent);
+	# byte sizes e.g. 10KB, 5MiB, 1.5GB
+	byte_unit = 'B' | ([KMGTP] 'i'? 'B');
+	bytes     = dec_int ('.' dec_int)? byte_unit;
 	bool   = 'true'i | 'false'i;
 	
 	# String types
@@ -24,6 +34,19 @@ import (
 	hex   = [0-9a-fA-F];
 	hex_string = hex{2} (':' hex{2})*;
 	
+	# Time types
+	# ---
+
+	# Go-style durations e.g. 5m, 1h30m, 1.5s
+	duration_unit = 'ns' | 'us' | 'µs' | 'ms' | 's' | 'm' | 'h';
+	duration = (digit+ ('.' digit+)? duration_unit)+;
+	# RFC 3339 timestamps e.g. 2026-01-01T00:00:00Z
+	timestamp = digit{4} '-' digit{2} '-' digit{2} 'T' digit{2} ':' digit{2} ':' digit{2} ('.' digit+)? ('Z' | ('+' | '-') digit{2} ':' digit{2});
+
+	# Semantic versions e.g. 1.12.0, 2.0.0-rc.1, 1.0.0+build.5
+	semver_ident = [0-9A-Za-z\-]+;
+	semver = digit+ '.' digit+ '.' digit+ ('-' semver_ident ('.' semver_ident)*)? ('+' semver_ident ('.' semver_ident)*)?;
+
 	# Network types
 	# ---
 	
@@ -43,9 +66,12 @@ import (
 	ip = ipv4 | ipv6;
 	ip_cidr = ip '/' digit{1,2};
 	
-	# MAC addresses e.g. 47:45:54 or 47-45-54
-	# mac_delim = ':' | '-';
-	# mac = hex{2} (mac_delim hex{2}){5,6};
+	# MAC addresses e.g. aa:bb:cc:dd:ee:ff, aa-bb-cc-dd-ee-ff or aabb.ccdd.eeff
+	mac = (hex{2} (':' hex{2}){5}) | (hex{2} ('-' hex{2}){5}) | (hex{4} '.' hex{4} '.' hex{4});
+	# MAC prefixes of at least 3 octets e.g. aa:bb:cc:* or aa:bb:cc/24
+	mac_prefix = (hex{2} (':' hex{2}){2,4} ':*') |
+	             (hex{2} ('-' hex{2}){2,4} '-*') |
+	             (hex{2} (':' hex{2}){2,5} '/' digit{1,2});
 
 	# Regex types
 	# ---
@@ -58,7 +84,10 @@ import (
     not_pipe_or_escape = any - ('|' | '\\');
 	regex_pipe = '|' ( not_pipe_or_escape | escaped_regex_char )* '|';
 
-    regex_pattern = regex_forward_slash | regex_pipe;
+	# optional trailing flags, e.g. /pattern/i
+	regex_flags = [ims]*;
+
+    regex_pattern = (regex_forward_slash | regex_pipe) regex_flags;
 
 	# Whitespace and comments
 	# ---
@@ -70,10 +99,19 @@ import (
 	field_char = alpha | digit | '_' | '.' | '-';
     field = (alpha | '_') field_char*;  # Must start with alpha or underscore
 	
+	# Field paths with quoted segments, which may contain any character, e.g. `k8s.io/app` or labels.`k8s.io/app`,
+	# or wildcard segments, e.g. items[*].name or items.*.name
+	quoted_ident  = '`' ( [^`\\] | '\\' any )* '`';
+	field_segment = (alpha | digit | '_' | '-')+;
+	field_path    = ((field '.')? quoted_ident | field ('.*' | '[*]')) ('[*]' | '.' (quoted_ident | field_segment | '*'))*;
+	
 	# Function names (similar to fields but can't contain dots)
 	function_char = alpha | digit | '_';
 	function = (alpha | '_') function_char*;  # Must start with alpha or underscore
 	
+	# Quantifiers include the opening parenthesis so that fields named any, all or none remain valid
+	quantifier = ('all'i | 'any'i | 'none'i) ws* '(';
+
 	# --- lexer logic ---
 	
 	main := |*
@@ -101,24 +139,66 @@ import (
 		('>=' | 'ge'i) => { token_kind = op_GE; fbreak; };
 
 		'contains'i         => { token_kind = op_CONTAINS; fbreak; };
+
+		# Case-insensitive comparison operators
+		'ieq'i       => { token_kind = op_IEQ;       fbreak; };
+		'ine'i       => { token_kind = op_INE;       fbreak; };
+		'icontains'i => { token_kind = op_ICONTAINS; fbreak; };
+
 		('=~' | 'matches'i) => { token_kind = op_MATCHES;  fbreak; };
 		'in'i               => { token_kind = op_IN;       fbreak; };
+		'glob'i             => { token_kind = op_GLOB;     fbreak; };
+		'hostglob'i         => { token_kind = op_HOSTGLOB; fbreak; };
+		'exists'i           => { token_kind = op_EXISTS;   fbreak; };
+
+		# Conditional operators
+		'?'      => { token_kind = op_QUESTION; fbreak; };
+		'??'     => { token_kind = op_COALESCE; fbreak; };
+		':'      => { token_kind = op_COLON;    fbreak; };
+		'if'i    => { token_kind = op_IF;       fbreak; };
+		'then'i  => { token_kind = op_THEN;     fbreak; };
+		'else'i  => { token_kind = op_ELSE;     fbreak; };
+
+		# Arithmetic operators
+		'+' => { token_kind = op_ADD; fbreak; };
+		'-' => { token_kind = op_SUB; fbreak; };
+		'*' => { token_kind = op_MUL; fbreak; };
+		'/' => { token_kind = op_DIV; fbreak; };
+		'%' => { token_kind = op_MOD; fbreak; };
+
+		# Ranges
+		'..' => { token_kind = op_RANGE; fbreak; };
+
+		# Pipes e.g. url | lower | trim
+		'|' => { token_kind = op_PIPE; fbreak; };
 
 		# Values
 		int    => { token_kind = token_INT;    fbreak; };
 		float  => { token_kind = token_FLOAT;  fbreak; };
+		bytes  => { token_kind = token_BYTES;  fbreak; };
 		bool   => { token_kind = token_BOOL;   fbreak; };
+		'null'i => { token_kind = token_NULL;  fbreak; };
 		string => { token_kind = token_STRING; fbreak; };
 
+		duration  => { token_kind = token_DURATION;  fbreak; };
+		timestamp => { token_kind = token_TIMESTAMP; fbreak; };
+		mac        => { token_kind = token_MAC;        fbreak; };
+		mac_prefix => { token_kind = token_MAC_PREFIX; fbreak; };
+		semver     => { token_kind = token_SEMVER;     fbreak; };
+
 		ip            => { token_kind = token_IP;         fbreak; };
 		ip_cidr       => { token_kind = token_IP_CIDR;    fbreak; };
 		hex_string    => { token_kind = token_HEX_STRING; fbreak; };
 		regex_pattern => { token_kind = token_REGEX;      fbreak; };
 
+		# Quantifiers e.g. all(x in ips, x in 10.0.0.0/8)
+		quantifier => { token_kind = token_QUANTIFIER; fbreak; };
+
 		function => { token_kind = token_FUNCTION; fbreak; };
 
 		# Field names (allow alphanumeric and dots with restrictions)
-		field => { token_kind = token_FIELD; fbreak; };
+		field        => { token_kind = token_FIELD;        fbreak; };
+		field_path   => { token_kind = token_FIELD_PATH;   fbreak; };
 
         # Add an error rule at the end to catch any unrecognized characters
         any => {
@@ -148,6 +228,9 @@ type ruleLexerImpl struct {
 	ts   int
 	te   int
 	eof  int
+	prev int // the previously returned token kind
+	// emit op_CONV_AND and op_CONV_OR, which give `and` a higher precedence than `or`
+	conventionalPrecedence bool
 	result Rule
 	err   string
 }
@@ -163,6 +246,27 @@ func newLex(line []byte) *ruleLexerImpl {
 func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
     token_kind := 0
 	%% write exec;
+	if token_kind == token_REGEX && lexer.data[lexer.ts] == '/' && endsOperand(lexer.prev) {
+		// a '/' following an operand is the division operator rather than the start of a regex,
+		// e.g. `a / b > c / d`. rewind to just after the slash.
+		lexer.te = lexer.ts + 1
+		lexer.p = lexer.te
+		token_kind = op_DIV
+	} else if token_kind == token_REGEX && lexer.data[lexer.ts] == '|' && endsOperand(lexer.prev) {
+		// likewise a '|' following an operand is a pipe, e.g. `url | lower | trim`
+		lexer.te = lexer.ts + 1
+		lexer.p = lexer.te
+		token_kind = op_PIPE
+	}
+	if lexer.conventionalPrecedence {
+		switch token_kind {
+		case op_AND:
+			token_kind = op_CONV_AND
+		case op_OR:
+			token_kind = op_CONV_OR
+		}
+	}
+	lexer.prev = token_kind
     if lexer.cs != ruleLexerImpl_error {
 		lval.valueLiteral = safeIndex(lexer.data, lexer.ts, lexer.te)
     }
@@ -173,6 +277,16 @@ func (lexer *ruleLexerImpl) Lex(lval *ruleSymType) int {
 	return token_kind
 }
 
+// endsOperand reports whether a token of the given kind may end an operand.
+func endsOperand(token_kind int) bool {
+	switch token_kind {
+	case token_FIELD, token_FIELD_PATH, token_FUNCTION, token_STRING, token_HEX_STRING, token_INT, token_FLOAT, token_BYTES,
+		token_BOOL, token_IP_CIDR, token_IP, token_REGEX, token_DURATION, token_TIMESTAMP, token_SEMVER, token_MAC, token_MAC_PREFIX, token_NULL, token_RPAREN, token_RBRACKET:
+		return true
+	}
+	return false
+}
+
 func (lexer *ruleLexerImpl) Error(s string) {
 	lexer.err = s
 }
@@ -0,0 +1,109 @@
+package rulekit
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// MACPrefix is a range of MAC addresses sharing a common prefix, retaining the original input string.
+// e.g. aa:bb:cc:* (an OUI) or aa:bb:cc:d0/28
+type MACPrefix struct {
+	raw_value string
+	Prefix    net.HardwareAddr
+	Bits      int
+}
+
+func (p MACPrefix) String() string {
+	return p.raw_value
+}
+
+// Contains reports whether the first Bits bits of mac match the prefix.
+func (p MACPrefix) Contains(mac net.HardwareAddr) bool {
+	if len(mac) < len(p.Prefix) {
+		return false
+	}
+	n := p.Bits / 8
+	if !bytes.Equal(mac[:n], p.Prefix[:n]) {
+		return false
+	}
+	if rem := p.Bits % 8; rem != 0 {
+		mask := byte(0xff << (8 - rem))
+		return mac[n]&mask == p.Prefix[n]&mask
+	}
+	return true
+}
+
+// minMACPrefixBits is the shortest MAC prefix, an OUI of 3 octets.
+const minMACPrefixBits = 24
+
+// ParseMACPrefix parses a MAC address prefix in wildcard (aa:bb:cc:* or aa-bb-cc-*)
+// or prefix length (aa:bb:cc/24) notation. A prefix must be at least 24 bits long.
+func ParseMACPrefix(s string) (MACPrefix, error) {
+	addr, bits, hasBits := strings.Cut(s, "/")
+	addr, wildcard := strings.CutSuffix(addr, "*")
+	if hasBits == wildcard {
+		return MACPrefix{}, fmt.Errorf("invalid MAC prefix %q", s)
+	}
+	if wildcard {
+		addr = addr[:len(addr)-1] // remove the trailing separator
+	}
+
+	octets := strings.FieldsFunc(addr, func(r rune) bool { return r == ':' || r == '-' })
+	if len(octets)*8 < minMACPrefixBits || len(octets) > 6 {
+		return MACPrefix{}, fmt.Errorf("invalid MAC prefix %q", s)
+	}
+	prefix := make(net.HardwareAddr, 6)
+	for i, o := range octets {
+		b, err := hex.DecodeString(o)
+		if err != nil || len(b) != 1 {
+			return MACPrefix{}, fmt.Errorf("invalid MAC prefix %q", s)
+		}
+		prefix[i] = b[0]
+	}
+
+	p := MACPrefix{
+		raw_value: s,
+		Prefix:    prefix,
+		Bits:      len(octets) * 8,
+	}
+	if hasBits {
+		n, err := strconv.Atoi(bits)
+		if err != nil || n < minMACPrefixBits || n > p.Bits {
+			return MACPrefix{}, fmt.Errorf("invalid MAC prefix length %q", s)
+		}
+		p.Bits = n
+	}
+	return p, nil
+}
+
+func compareMACPrefix(left MACPrefix, op int, right any) (ret bool) {
+	defer func() {
+		debugResult(ret, "│ cmpMacPrefix", "", left, op, right)
+	}()
+	mac, ok := toMAC(right)
+	if !ok {
+		return false
+	}
+	switch op {
+	case op_EQ, op_CONTAINS:
+		return left.Contains(mac)
+	case op_NE:
+		return !left.Contains(mac)
+	}
+	return false
+}
+
+func toMAC(v any) (net.HardwareAddr, bool) {
+	switch v := v.(type) {
+	case net.HardwareAddr:
+		return v, true
+	case string:
+		mac, err := net.ParseMAC(v)
+		return mac, err == nil
+	}
+	return nil, false
+}
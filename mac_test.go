@@ -0,0 +1,77 @@
+package rulekit
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMACPrefix(t *testing.T) {
+	for _, tc := range []struct {
+		prefix  string
+		bits    int
+		match   []string
+		noMatch []string
+	}{
+		{
+			prefix:  "aa:bb:cc:*",
+			bits:    24,
+			match:   []string{"aa:bb:cc:00:00:00", "AA:BB:CC:dd:ee:ff"},
+			noMatch: []string{"aa:bb:cd:00:00:00", "00:bb:cc:dd:ee:ff"},
+		},
+		{
+			prefix:  "aa-bb-cc-dd-*",
+			bits:    32,
+			match:   []string{"aa:bb:cc:dd:00:01"},
+			noMatch: []string{"aa:bb:cc:de:00:01"},
+		},
+		{
+			prefix:  "aa:bb:cc/24",
+			bits:    24,
+			match:   []string{"aa:bb:cc:dd:ee:ff"},
+			noMatch: []string{"aa:bb:dd:dd:ee:ff"},
+		},
+		{
+			prefix:  "aa:bb:cc:d0/28",
+			bits:    28,
+			match:   []string{"aa:bb:cc:d0:00:00", "aa:bb:cc:df:ff:ff"},
+			noMatch: []string{"aa:bb:cc:e0:00:00", "aa:bb:cc:c0:00:00"},
+		},
+		{
+			prefix:  "aa:bb:cc:dd:ee:ff/48",
+			bits:    48,
+			match:   []string{"aa:bb:cc:dd:ee:ff"},
+			noMatch: []string{"aa:bb:cc:dd:ee:fe"},
+		},
+	} {
+		p, err := ParseMACPrefix(tc.prefix)
+		require.NoError(t, err)
+		require.Equal(t, tc.bits, p.Bits)
+		require.Equal(t, tc.prefix, p.String())
+		for _, s := range tc.match {
+			require.Truef(t, p.Contains(mustParseMac(s)), "%s should contain %s", tc.prefix, s)
+		}
+		for _, s := range tc.noMatch {
+			require.Falsef(t, p.Contains(mustParseMac(s)), "%s should not contain %s", tc.prefix, s)
+		}
+	}
+
+	// prefixes shorter than an OUI
+	for _, s := range []string{"aa:bb:cc/23", "aa:bb:cc/7", "aa:bb:cc/0", "aa:bb:cc:dd/16", "aa:bb:*", "aa-*"} {
+		_, err := ParseMACPrefix(s)
+		require.Errorf(t, err, "%q", s)
+	}
+	p, err := ParseMACPrefix("aa:bb:cc:dd/24")
+	require.NoError(t, err)
+	require.Equal(t, 24, p.Bits)
+
+	for _, s := range []string{"aa:bb:cc", "aa:bb:cc/25", "aa:bb:cc:*/24", "aa:bb:cc:dd:ee:ff:00/24", "aa:bb:zz:*"} {
+		_, err := ParseMACPrefix(s)
+		require.Errorf(t, err, "%q", s)
+	}
+
+	// shorter addresses are not contained
+	p, _ = ParseMACPrefix("aa:bb:cc:*")
+	require.False(t, p.Contains(net.HardwareAddr{0xaa, 0xbb}))
+}
@@ -1,8 +1,13 @@
 package rulekit
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"regexp"
+	"strings"
+
+	"github.com/qpoint-io/rulekit/set"
 )
 
 // AND
@@ -48,7 +53,7 @@ func (n *nodeAnd) Eval(ctx *Ctx) Result {
 }
 
 func (n *nodeAnd) String() string {
-	return fmt.Sprintf("(%s and %s)", n.left.String(), n.right.String())
+	return fmt.Sprintf("(%s and %s)", operandString(n.left, precNot, false), operandString(n.right, precNot, false))
 }
 
 // OR
@@ -94,7 +99,7 @@ func (n *nodeOr) Eval(ctx *Ctx) Result {
 }
 
 func (n *nodeOr) String() string {
-	return fmt.Sprintf("(%s or %s)", n.left.String(), n.right.String())
+	return fmt.Sprintf("(%s or %s)", operandString(n.left, precNot, false), operandString(n.right, precNot, false))
 }
 
 // NOT
@@ -116,7 +121,7 @@ func (n *nodeNot) Eval(ctx *Ctx) Result {
 	}
 
 	return Result{
-		Value:         !isZero(r.Value),
+		Value:         isZero(r.Value),
 		EvaluatedRule: n,
 	}
 }
@@ -133,6 +138,10 @@ func (n *nodeNot) String() string {
 	} else if nn, ok := n.right.(FieldValue); ok {
 		// special formatting for !FIELD (no space between ! and field)
 		return "!" + nn.String()
+	} else if nn, ok := n.right.(FieldPath); ok {
+		return "!" + nn.String()
+	} else if nn, ok := n.right.(*nodeExists); ok {
+		return "!" + nn.String()
 	} else if nn, ok := n.right.(*nodeMatch); ok {
 		// special formatting for field not =~ /pattern/
 		return nn.lv.String() + " not =~ " + nn.rv.String()
@@ -196,7 +205,42 @@ func (n *nodeMatch) FieldName() string {
 }
 
 func (n *nodeMatch) String() string {
-	return n.lv.String() + " =~ " + n.rv.String()
+	return operandString(n.lv, precCompare, false) + " =~ " + n.rv.String()
+}
+
+// Glob node: `path glob "/api/*/users/**"` or `host hostglob "*.example.com"`
+type nodeGlob struct {
+	lv Rule
+	op int
+	rv Rule
+}
+
+func (n *nodeGlob) Eval(ctx *Ctx) Result {
+	lv := n.lv.Eval(ctx)
+	if !lv.Ok() {
+		return Result{
+			Error:         lv.Error,
+			EvaluatedRule: n,
+		}
+	}
+	rv := n.rv.Eval(ctx)
+	if !rv.Ok() {
+		return Result{
+			Error:         rv.Error,
+			EvaluatedRule: n,
+		}
+	}
+
+	// strings and string slices are matched against the glob
+	pass := compare(lv.Value, op_EQ, rv.Value)
+	return Result{
+		Value:         pass,
+		EvaluatedRule: n,
+	}
+}
+
+func (n *nodeGlob) String() string {
+	return operandString(n.lv, precCompare, false) + " " + operatorToString(n.op) + " " + n.rv.String()
 }
 
 // Comparison node
@@ -208,20 +252,22 @@ type nodeCompare struct {
 
 func (n *nodeCompare) Eval(ctx *Ctx) Result {
 	lv := n.lv.Eval(ctx)
-	if !lv.Ok() {
+	if !lv.Ok() && !(isNullLiteral(n.rv) && isMissing(lv.Error)) {
 		return Result{
 			Error:         lv.Error,
 			EvaluatedRule: n,
 		}
 	}
 	rv := n.rv.Eval(ctx)
-	if !rv.Ok() {
+	if !rv.Ok() && !(isNullLiteral(n.lv) && isMissing(rv.Error)) {
 		return Result{
 			Error:         rv.Error,
 			EvaluatedRule: n,
 		}
 	}
 
+	// a missing operand compared against null evaluates as null, so
+	// `field == null` is true both when the field is absent and when it is nil.
 	pass := compare(lv.Value, n.op, rv.Value)
 	return Result{
 		Value:         pass,
@@ -229,8 +275,139 @@ func (n *nodeCompare) Eval(ctx *Ctx) Result {
 	}
 }
 
+func isNullLiteral(r Rule) bool {
+	lit, ok := r.(*LiteralValue[any])
+	return ok && lit.value == nil
+}
+
 func (n *nodeCompare) String() string {
-	return n.lv.String() + " " + operatorToString(n.op) + " " + n.rv.String()
+	return operandString(n.lv, precCompare, false) + " " + operatorToString(n.op) + " " + operandString(n.rv, precCompare, true)
+}
+
+// Exists node: `exists field` is true if the operand can be evaluated, even if its value is nil.
+// A missing field or an index out of range is reported as false rather than an error.
+type nodeExists struct {
+	right Rule
+}
+
+func (n *nodeExists) Eval(ctx *Ctx) Result {
+	res := n.right.Eval(ctx)
+	if !res.Ok() && !isMissing(res.Error) {
+		return Result{
+			Error:         res.Error,
+			EvaluatedRule: n,
+		}
+	}
+	return Result{
+		Value:         res.Ok(),
+		EvaluatedRule: n,
+	}
+}
+
+func (n *nodeExists) String() string {
+	return "exists(" + n.right.String() + ")"
+}
+
+// Range node: `low..high` evaluates to an inclusive Range
+type nodeRange struct {
+	low  Rule
+	high Rule
+}
+
+func (n *nodeRange) Eval(ctx *Ctx) Result {
+	low := n.low.Eval(ctx)
+	if !low.Ok() {
+		return Result{
+			Error:         low.Error,
+			EvaluatedRule: n,
+		}
+	}
+	high := n.high.Eval(ctx)
+	if !high.Ok() {
+		return Result{
+			Error:         high.Error,
+			EvaluatedRule: n,
+		}
+	}
+	return Result{
+		Value:         Range{Low: low.Value, High: high.Value},
+		EvaluatedRule: n,
+	}
+}
+
+func (n *nodeRange) String() string {
+	_, lowLit := n.low.(*LiteralValue[any])
+	_, highLit := n.high.(*LiteralValue[any])
+	sep := ".."
+	if !lowLit || !highLit {
+		// `lo..hi` would be parsed as a single field name
+		sep = " .. "
+	}
+	return operandString(n.low, precRange, true) + sep + operandString(n.high, precRange, true)
+}
+
+// Coalesce node: `field ?? default` returns the default if the field is missing or nil.
+type nodeCoalesce struct {
+	left  Rule
+	right Rule
+}
+
+func (n *nodeCoalesce) Eval(ctx *Ctx) Result {
+	res := n.left.Eval(ctx)
+	if !res.Ok() && !isMissing(res.Error) {
+		return Result{
+			Error:         res.Error,
+			EvaluatedRule: n,
+		}
+	}
+	if !res.Ok() || res.Value == nil {
+		res = n.right.Eval(ctx)
+	}
+	res.EvaluatedRule = n
+	return res
+}
+
+func (n *nodeCoalesce) String() string {
+	// right-associative: a ?? b ?? c is a ?? (b ?? c)
+	return operandString(n.left, precCoalesce, true) + " ?? " + operandString(n.right, precCoalesce, false)
+}
+
+// isMissing reports whether err is caused only by absent values.
+func isMissing(err error) bool {
+	var mf *ErrMissingFields
+	var ior *ErrIndexOutOfRange
+	return errors.As(err, &mf) || errors.As(err, &ior)
+}
+
+// Conditional node: `cond ? then : els` or `if cond then then else els`
+type nodeCond struct {
+	cond    Rule
+	then    Rule
+	els     Rule
+	ternary bool // formatting only
+}
+
+func (n *nodeCond) Eval(ctx *Ctx) Result {
+	c := n.cond.Eval(ctx)
+	if !c.Ok() {
+		return Result{
+			Error:         c.Error,
+			EvaluatedRule: n,
+		}
+	}
+
+	// only the chosen branch is evaluated
+	if c.Pass() {
+		return n.then.Eval(ctx)
+	}
+	return n.els.Eval(ctx)
+}
+
+func (n *nodeCond) String() string {
+	if n.ternary {
+		return operandString(n.cond, precCond, true) + " ? " + n.then.String() + " : " + n.els.String()
+	}
+	return "if " + n.cond.String() + " then " + n.then.String() + " else " + operandString(n.els, precCompare, true)
 }
 
 // TEST_IN
@@ -255,22 +432,221 @@ func (n *nodeIn) Eval(ctx *Ctx) Result {
 		}
 	}
 
-	rvArr, ok := rv.Value.([]any)
-	if !ok {
-		// the right value must be an array
+	if !isInOperand(rv.Value) {
+		// e.g. a string, which is not a substring test
 		return Result{
+			Value:         false,
 			EvaluatedRule: n,
 		}
 	}
 
 	// `FIELD in ARR` == `ARR contains FIELD`
-	pass := compare(rvArr, op_CONTAINS, lv.Value)
+	pass := compare(rv.Value, op_CONTAINS, lv.Value)
 	return Result{
 		Value:         pass,
 		EvaluatedRule: n,
 	}
 }
 
+// isInOperand reports whether v may be the right side of in: an array, range, CIDR or MAC prefix.
+func isInOperand(v any) bool {
+	switch v.(type) {
+	case []any, []string, []int, []int64, []uint, []uint64, []float32, []float64,
+		Range, *net.IPNet, MACPrefix:
+		return true
+	}
+	return false
+}
+
 func (n *nodeIn) String() string {
-	return n.lv.String() + " in " + n.rv.String()
+	return operandString(n.lv, precCompare, false) + " in " + operandString(n.rv, precCompare, true)
+}
+
+// Arithmetic node
+type nodeArith struct {
+	lv Rule
+	op int // op_ADD, SUB, MUL, DIV, MOD
+	rv Rule
+}
+
+func (n *nodeArith) Eval(ctx *Ctx) Result {
+	lv := n.lv.Eval(ctx)
+	if !lv.Ok() {
+		return Result{
+			Error:         lv.Error,
+			EvaluatedRule: n,
+		}
+	}
+	rv := n.rv.Eval(ctx)
+	if !rv.Ok() {
+		return Result{
+			Error:         rv.Error,
+			EvaluatedRule: n,
+		}
+	}
+
+	val, err := arith(lv.Value, n.op, rv.Value)
+	return Result{
+		Value:         val,
+		EvaluatedRule: n,
+		Error:         err,
+	}
+}
+
+func (n *nodeArith) String() string {
+	prec := precedence(n)
+	return operandString(n.lv, prec, false) + " " + operatorToString(n.op) + " " + operandString(n.rv, prec, true)
+}
+
+// Binding strength of each node type, used to parenthesize operands when formatting a rule.
+const (
+	precCond = iota + 1
+	precNot
+	precCompare
+	precRange
+	precCoalesce
+	precAdd
+	precMul
+	precValue
+)
+
+func precedence(r Rule) int {
+	switch n := r.(type) {
+	case *nodeCond:
+		return precCond
+	case *nodeNot:
+		return precNot
+	case *nodeCompare, *nodeMatch, *nodeGlob, *nodeIn:
+		return precCompare
+	case *nodeRange:
+		return precRange
+	case *nodeCoalesce:
+		return precCoalesce
+	case *nodeArith:
+		switch n.op {
+		case op_MUL, op_DIV, op_MOD:
+			return precMul
+		}
+		return precAdd
+	}
+	// values and nodes that wrap themselves in parentheses (and, or)
+	return precValue
+}
+
+// operandString formats an operand of a binary operator with the given precedence. Operands only need
+// parentheses when they bind looser than the operator, e.g. (a + b) * c, or equally tight on the right
+// side, e.g. a - (b - c). Comparisons are non-associative so they are parenthesized on either side.
+func operandString(r Rule, prec int, right bool) string {
+	p := precedence(r)
+	if p < prec || (p == prec && (right || prec == precCompare)) {
+		return "(" + r.String() + ")"
+	}
+	return r.String()
+}
+
+// Index node: `lv[index]`
+type nodeIndex struct {
+	lv    Rule
+	index Rule
+}
+
+func (n *nodeIndex) Eval(ctx *Ctx) Result {
+	lv := n.lv.Eval(ctx)
+	if !lv.Ok() {
+		return Result{
+			Error:         lv.Error,
+			EvaluatedRule: n,
+		}
+	}
+	idx := n.index.Eval(ctx)
+	if !idx.Ok() {
+		return Result{
+			Error:         idx.Error,
+			EvaluatedRule: n,
+		}
+	}
+
+	val, ok, err := indexValue(lv.Value, idx.Value)
+	if err == nil && !ok {
+		// the key is not present in the map
+		err = &ErrMissingFields{Fields: set.NewSet(n.String())}
+	}
+	return Result{
+		Value:         val,
+		EvaluatedRule: n,
+		Error:         err,
+	}
+}
+
+func (n *nodeIndex) String() string {
+	return operandString(n.lv, precValue, false) + "[" + n.index.String() + "]"
+}
+
+// Quantifier node: `all(x in coll, pred)`, `any(...)` or `none(...)`
+type nodeQuantifier struct {
+	quantifier string // all, any or none
+	name       string // the loop variable bound for pred
+	coll       Rule
+	pred       Rule
+}
+
+func newQuantifier(token string, name string, coll Rule, pred Rule) *nodeQuantifier {
+	return &nodeQuantifier{
+		quantifier: strings.ToLower(strings.TrimRight(token, " \t\n\r(")),
+		name:       name,
+		coll:       coll,
+		pred:       pred,
+	}
+}
+
+func (n *nodeQuantifier) Eval(ctx *Ctx) Result {
+	coll := n.coll.Eval(ctx)
+	if !coll.Ok() {
+		return Result{
+			Error:         coll.Error,
+			EvaluatedRule: n,
+		}
+	}
+
+	// the loop variable shadows KV keys inside the predicate
+	inner := *ctx
+	scope := &scope{name: n.name, parent: ctx.scope}
+	inner.scope = scope
+
+	var (
+		// any: whether an element passed. all: whether an element failed.
+		found bool
+		errs  []error
+	)
+	err := forEachValue(coll.Value, func(el any) bool {
+		scope.value = el
+		r := n.pred.Eval(&inner)
+		switch {
+		case !r.Ok():
+			errs = append(errs, r.Error)
+		case n.quantifier == "all" && r.Fail(), n.quantifier != "all" && r.Pass():
+			found = true
+			return false
+		}
+		return true
+	})
+	if err == nil && !found {
+		// the result is undetermined if any element could not be evaluated
+		err = coalesceErrs(errs...)
+	}
+	if err != nil {
+		return Result{
+			Error:         err,
+			EvaluatedRule: n,
+		}
+	}
+
+	return Result{
+		Value:         found == (n.quantifier == "any"),
+		EvaluatedRule: n,
+	}
+}
+
+func (n *nodeQuantifier) String() string {
+	return n.quantifier + "(" + n.name + " in " + n.coll.String() + ", " + n.pred.String() + ")"
 }
@@ -27,25 +27,55 @@ const token_BOOL = 57352
 const token_IP_CIDR = 57353
 const token_IP = 57354
 const token_REGEX = 57355
-const op_NOT = 57356
-const op_AND = 57357
-const op_OR = 57358
-const token_LPAREN = 57359
-const token_RPAREN = 57360
-const token_LBRACKET = 57361
-const token_RBRACKET = 57362
-const token_COMMA = 57363
-const op_EQ = 57364
-const op_NE = 57365
-const op_GT = 57366
-const op_GE = 57367
-const op_LT = 57368
-const op_LE = 57369
-const op_CONTAINS = 57370
-const op_MATCHES = 57371
-const op_IN = 57372
-const token_ARRAY = 57373
-const token_ERROR = 57374
+const token_DURATION = 57356
+const token_TIMESTAMP = 57357
+const token_SEMVER = 57358
+const token_BYTES = 57359
+const token_MAC = 57360
+const token_MAC_PREFIX = 57361
+const token_FIELD_PATH = 57362
+const token_NULL = 57363
+const token_QUANTIFIER = 57364
+const op_NOT = 57365
+const op_AND = 57366
+const op_OR = 57367
+const op_CONV_AND = 57368
+const op_CONV_OR = 57369
+const token_LPAREN = 57370
+const token_RPAREN = 57371
+const token_LBRACKET = 57372
+const token_RBRACKET = 57373
+const token_COMMA = 57374
+const op_EQ = 57375
+const op_NE = 57376
+const op_GT = 57377
+const op_GE = 57378
+const op_LT = 57379
+const op_LE = 57380
+const op_CONTAINS = 57381
+const op_MATCHES = 57382
+const op_IN = 57383
+const op_EXISTS = 57384
+const op_IEQ = 57385
+const op_INE = 57386
+const op_ICONTAINS = 57387
+const op_GLOB = 57388
+const op_HOSTGLOB = 57389
+const op_RANGE = 57390
+const op_PIPE = 57391
+const op_ADD = 57392
+const op_SUB = 57393
+const op_MUL = 57394
+const op_DIV = 57395
+const op_MOD = 57396
+const op_QUESTION = 57397
+const op_COLON = 57398
+const op_IF = 57399
+const op_THEN = 57400
+const op_ELSE = 57401
+const op_COALESCE = 57402
+const token_ARRAY = 57403
+const token_ERROR = 57404
 
 var ruleToknames = [...]string{
 	"$end",
@@ -61,9 +91,20 @@ var ruleToknames = [...]string{
 	"token_IP_CIDR",
 	"token_IP",
 	"token_REGEX",
+	"token_DURATION",
+	"token_TIMESTAMP",
+	"token_SEMVER",
+	"token_BYTES",
+	"token_MAC",
+	"token_MAC_PREFIX",
+	"token_FIELD_PATH",
+	"token_NULL",
+	"token_QUANTIFIER",
 	"op_NOT",
 	"op_AND",
 	"op_OR",
+	"op_CONV_AND",
+	"op_CONV_OR",
 	"token_LPAREN",
 	"token_RPAREN",
 	"token_LBRACKET",
@@ -78,6 +119,25 @@ var ruleToknames = [...]string{
 	"op_CONTAINS",
 	"op_MATCHES",
 	"op_IN",
+	"op_EXISTS",
+	"op_IEQ",
+	"op_INE",
+	"op_ICONTAINS",
+	"op_GLOB",
+	"op_HOSTGLOB",
+	"op_RANGE",
+	"op_PIPE",
+	"op_ADD",
+	"op_SUB",
+	"op_MUL",
+	"op_DIV",
+	"op_MOD",
+	"op_QUESTION",
+	"op_COLON",
+	"op_IF",
+	"op_THEN",
+	"op_ELSE",
+	"op_COALESCE",
 	"token_ARRAY",
 	"token_ERROR",
 }
@@ -88,76 +148,191 @@ const ruleEofCode = 1
 const ruleErrCode = 2
 const ruleInitialStackSize = 16
 
-//line parser.y:297
+//line parser.y:550
 
 //line yacctab:1
 var ruleExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 85,
+	33, 0,
+	34, 0,
+	35, 0,
+	36, 0,
+	37, 0,
+	38, 0,
+	39, 0,
+	40, 0,
+	41, 0,
+	43, 0,
+	44, 0,
+	45, 0,
+	46, 0,
+	47, 0,
+	-2, 10,
+	-1, 86,
+	33, 0,
+	34, 0,
+	35, 0,
+	36, 0,
+	37, 0,
+	38, 0,
+	39, 0,
+	40, 0,
+	41, 0,
+	43, 0,
+	44, 0,
+	45, 0,
+	46, 0,
+	47, 0,
+	-2, 11,
+	-1, 89,
+	33, 0,
+	34, 0,
+	35, 0,
+	36, 0,
+	37, 0,
+	38, 0,
+	39, 0,
+	40, 0,
+	41, 0,
+	43, 0,
+	44, 0,
+	45, 0,
+	46, 0,
+	47, 0,
+	-2, 14,
+	-1, 92,
+	48, 0,
+	-2, 17,
 }
 
 const rulePrivate = 57344
 
-const ruleLast = 99
+const ruleLast = 473
 
 var ruleAct = [...]int8{
-	5, 6, 12, 33, 34, 53, 13, 36, 54, 35,
-	31, 32, 26, 27, 28, 29, 52, 51, 22, 48,
-	1, 39, 46, 38, 23, 24, 44, 20, 21, 22,
-	49, 39, 45, 21, 22, 37, 43, 39, 50, 47,
-	9, 40, 41, 42, 7, 8, 30, 25, 11, 2,
-	0, 0, 39, 0, 55, 39, 56, 9, 10, 14,
-	18, 7, 8, 15, 17, 16, 19, 3, 0, 0,
-	4, 0, 20, 9, 10, 14, 18, 7, 8, 15,
-	17, 16, 19, 0, 0, 0, 0, 0, 20, 9,
-	40, 14, 18, 7, 8, 15, 17, 16, 19,
+	100, 2, 99, 48, 67, 68, 69, 70, 48, 98,
+	116, 108, 87, 109, 109, 102, 101, 48, 105, 72,
+	88, 46, 49, 62, 63, 64, 65, 66, 77, 95,
+	64, 65, 66, 47, 71, 80, 81, 82, 83, 84,
+	85, 86, 78, 79, 89, 90, 91, 92, 93, 94,
+	34, 35, 36, 37, 8, 119, 48, 11, 13, 50,
+	51, 56, 57, 58, 59, 52, 41, 43, 10, 53,
+	54, 55, 60, 61, 46, 49, 62, 63, 64, 65,
+	66, 38, 74, 75, 76, 45, 47, 44, 73, 42,
+	39, 40, 9, 1, 0, 0, 0, 0, 106, 107,
+	0, 0, 110, 0, 111, 0, 0, 0, 112, 0,
+	115, 0, 0, 0, 117, 118, 34, 35, 36, 37,
+	0, 0, 48, 0, 114, 50, 51, 56, 57, 58,
+	59, 52, 41, 43, 0, 53, 54, 55, 60, 61,
+	46, 49, 62, 63, 64, 65, 66, 38, 34, 35,
+	36, 37, 47, 0, 48, 0, 0, 50, 51, 56,
+	57, 58, 59, 52, 41, 43, 0, 53, 54, 55,
+	60, 61, 46, 49, 62, 63, 64, 65, 66, 38,
+	0, 0, 0, 113, 47, 34, 35, 36, 37, 0,
+	0, 48, 104, 0, 50, 51, 56, 57, 58, 59,
+	52, 41, 43, 0, 53, 54, 55, 60, 61, 46,
+	49, 62, 63, 64, 65, 66, 38, 34, 35, 36,
+	37, 47, 0, 48, 0, 0, 50, 51, 56, 57,
+	58, 59, 52, 41, 43, 0, 53, 54, 55, 60,
+	61, 46, 49, 62, 63, 64, 65, 66, 38, 103,
+	0, 0, 0, 47, 34, 35, 36, 37, 0, 0,
+	48, 0, 0, 50, 51, 56, 57, 58, 59, 52,
+	41, 43, 0, 53, 54, 55, 60, 61, 46, 49,
+	62, 63, 64, 65, 66, 38, 0, 0, 97, 0,
+	47, 34, 35, 36, 37, 0, 96, 48, 0, 0,
+	50, 51, 56, 57, 58, 59, 52, 41, 43, 0,
+	53, 54, 55, 60, 61, 46, 49, 62, 63, 64,
+	65, 66, 38, 34, 35, 36, 37, 47, 0, 48,
+	0, 0, 50, 51, 56, 57, 58, 59, 52, 41,
+	43, 0, 53, 54, 55, 60, 61, 46, 49, 62,
+	63, 64, 65, 66, 38, 35, 36, 0, 0, 47,
+	48, 0, 0, 50, 51, 56, 57, 58, 59, 52,
+	41, 43, 0, 53, 54, 55, 60, 61, 46, 49,
+	62, 63, 64, 65, 66, 0, 0, 0, 0, 0,
+	47, 32, 12, 14, 18, 28, 29, 15, 17, 16,
+	19, 20, 26, 25, 30, 23, 24, 33, 22, 7,
+	3, 48, 0, 0, 0, 4, 0, 27, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6,
+	0, 62, 63, 64, 65, 66, 0, 31, 21, 0,
+	0, 47, 48, 0, 5, 50, 51, 56, 57, 58,
+	59, 52, 41, 43, 0, 53, 54, 55, 60, 61,
+	46, 49, 62, 63, 64, 65, 66, 0, 0, 0,
+	0, 0, 47,
 }
 
 var rulePact = [...]int16{
-	53, 13, -1000, 53, 53, -12, -19, -1000, -1000, -1000,
-	-10, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	85, 53, 53, -1000, 18, 36, -1000, -1000, -1000, -1000,
-	69, 9, 8, -1000, -1000, -1000, 69, -4, -1000, -1000,
-	-1000, 2, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -13,
-	-1000, 85, -1000, -1000, 69, -1000, -1000,
+	387, -1000, 299, 387, 387, 387, 387, 29, -1000, -1000,
+	-1000, -1000, -9, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 74, -1000, -1000, -1000, -1000, -1000, 387, -1000, -1000,
+	-1000, 34, -1000, -1000, 387, 387, 387, 387, 387, 387,
+	387, -1, 14, 387, 387, 387, 387, 387, 387, 24,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 412, 267, 230,
+	-13, -32, 387, -1000, -1000, -1000, -16, 299, -1000, -1000,
+	330, 412, 412, 330, 193, -27, -27, -1000, -1000, -27,
+	-22, -13, 381, 381, 161, -10, -1000, 387, 387, -18,
+	299, 387, -1000, 387, -1000, 387, 124, 92, -1000, 387,
+	299, 299, -19, 387, 387, 299, -1000, -27, 26, -1000,
 }
 
 var rulePgo = [...]int8{
-	0, 20, 49, 48, 47, 46, 35, 2, 0, 6,
-	1, 30,
+	0, 93, 0, 92, 91, 90, 89, 87, 85, 84,
+	68, 58, 57, 54, 2,
 }
 
 var ruleR1 = [...]int8{
-	0, 1, 1, 1, 1, 1, 2, 2, 2, 2,
-	2, 2, 4, 4, 4, 4, 5, 5, 5, 6,
-	6, 9, 10, 10, 10, 7, 7, 7, 7, 7,
-	7, 7, 8, 8, 8, 8, 3, 11, 11, 11,
+	0, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 4, 4, 4, 4, 5,
+	5, 5, 5, 5, 5, 6, 6, 7, 7, 8,
+	8, 8, 9, 9, 12, 13, 13, 13, 10, 10,
+	10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+	10, 10, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 3, 14, 14, 14,
 }
 
 var ruleR2 = [...]int8{
-	0, 1, 3, 3, 2, 3, 3, 3, 3, 1,
-	3, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 4, 1, 3, 0,
+	0, 1, 3, 3, 3, 3, 2, 3, 5, 6,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	4, 3, 6, 7, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 1,
+	1, 1, 4, 1, 3, 0,
 }
 
 var ruleChk = [...]int16{
-	-1000, -1, -2, 14, 17, -8, -10, 8, 9, 4,
-	5, -3, -7, -9, 6, 10, 12, 11, 7, 13,
-	19, 15, 16, -1, -1, -4, 24, 25, 26, 27,
-	-5, 29, 30, 22, 23, 28, 17, -6, -7, -8,
-	5, -1, -1, 18, -8, -10, 13, -9, 11, -11,
-	-10, 21, 20, 18, 21, -7, -10,
+	-1000, -1, -2, 23, 28, 57, 42, 22, -13, -3,
+	-10, -12, 5, -11, 6, 10, 12, 11, 7, 13,
+	14, 51, 21, 18, 19, 16, 15, 30, 8, 9,
+	17, 50, 4, 20, 24, 25, 26, 27, 55, -5,
+	-4, 40, -6, 41, -7, -8, 48, 60, 30, 49,
+	33, 34, 39, 43, 44, 45, 35, 36, 37, 38,
+	46, 47, 50, 51, 52, 53, 54, -2, -2, -2,
+	-2, 5, 28, 14, 8, 9, -9, -2, 8, 9,
+	-2, -2, -2, -2, -2, -2, -2, 13, 6, -2,
+	-2, -2, -2, -2, -2, 5, 29, 58, 41, -14,
+	-2, 32, 31, 56, 31, 28, -2, -2, 29, 32,
+	-2, -2, -14, 59, 32, -2, 29, -2, -2, 29,
 }
 
 var ruleDef = [...]int8{
-	0, -2, 1, 0, 0, 25, 9, 32, 33, 34,
-	35, 22, 23, 24, 26, 27, 28, 29, 30, 31,
-	0, 0, 0, 4, 0, 0, 12, 13, 14, 15,
-	0, 0, 0, 16, 17, 18, 39, 0, 19, 25,
-	35, 2, 3, 5, 6, 7, 8, 10, 11, 0,
-	37, 0, 21, 36, 0, 20, 38,
+	0, -2, 1, 0, 0, 0, 0, 0, 24, 45,
+	46, 47, 71, 48, 49, 50, 51, 52, 53, 54,
+	55, 0, 57, 58, 59, 60, 61, 0, 62, 63,
+	64, 0, 69, 70, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	29, 30, 31, 32, 33, 34, 25, 26, 27, 28,
+	35, 36, 37, 38, 39, 40, 41, 6, 0, 0,
+	19, 0, 75, 56, 65, 67, 0, 42, 66, 68,
+	2, 3, 4, 5, 0, -2, -2, 12, 13, -2,
+	15, 16, -2, 18, 0, 21, 7, 0, 0, 0,
+	73, 0, 44, 0, 20, 75, 0, 0, 72, 0,
+	43, 8, 0, 0, 0, 74, 22, 9, 0, 23,
 }
 
 var ruleTok1 = [...]int8{
@@ -168,7 +343,10 @@ var ruleTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
-	32,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62,
 }
 
 var ruleTok3 = [...]int8{
@@ -515,42 +693,62 @@ ruledefault:
 
 	case 1:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:52
+//line parser.y:84
 		{
 			ruleVAL.rule = ruleDollar[1].rule
 			rulelex.Result(ruleVAL.rule)
 		}
 	case 2:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:57
+//line parser.y:92
 		{
 			ruleVAL.rule = &nodeAnd{left: ruleDollar[1].rule, right: ruleDollar[3].rule}
-			rulelex.Result(ruleVAL.rule)
 		}
 	case 3:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:62
+//line parser.y:96
 		{
 			ruleVAL.rule = &nodeOr{left: ruleDollar[1].rule, right: ruleDollar[3].rule}
-			rulelex.Result(ruleVAL.rule)
 		}
 	case 4:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:100
+		{
+			ruleVAL.rule = &nodeAnd{left: ruleDollar[1].rule, right: ruleDollar[3].rule}
+		}
+	case 5:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:104
+		{
+			ruleVAL.rule = &nodeOr{left: ruleDollar[1].rule, right: ruleDollar[3].rule}
+		}
+	case 6:
 		ruleDollar = ruleS[rulept-2 : rulept+1]
-//line parser.y:67
+//line parser.y:108
 		{
 			ruleVAL.rule = &nodeNot{right: ruleDollar[2].rule}
-			rulelex.Result(ruleVAL.rule)
 		}
-	case 5:
+	case 7:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:72
+//line parser.y:112
 		{
 			ruleVAL.rule = ruleDollar[2].rule
-			rulelex.Result(ruleVAL.rule)
 		}
-	case 6:
+	case 8:
+		ruleDollar = ruleS[rulept-5 : rulept+1]
+//line parser.y:117
+		{
+			ruleVAL.rule = &nodeCond{cond: ruleDollar[1].rule, then: ruleDollar[3].rule, els: ruleDollar[5].rule, ternary: true}
+		}
+	case 9:
+		ruleDollar = ruleS[rulept-6 : rulept+1]
+//line parser.y:121
+		{
+			ruleVAL.rule = &nodeCond{cond: ruleDollar[2].rule, then: ruleDollar[4].rule, els: ruleDollar[6].rule}
+		}
+	case 10:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:81
+//line parser.y:126
 		{
 			ruleVAL.rule = &nodeCompare{
 				lv: ruleDollar[1].rule,
@@ -558,19 +756,23 @@ ruledefault:
 				rv: ruleDollar[3].rule,
 			}
 		}
-	case 7:
+	case 11:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:90
+//line parser.y:135
 		{
+			if err := validateOperands(ruleDollar[2].operator, ruleDollar[1].rule, ruleDollar[3].rule); err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
 			ruleVAL.rule = &nodeCompare{
 				lv: ruleDollar[1].rule,
 				op: ruleDollar[2].operator,
 				rv: ruleDollar[3].rule,
 			}
 		}
-	case 8:
+	case 12:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:99
+//line parser.y:148
 		{
 			elem, err := parseValueToken(token_REGEX, ruleDollar[3].valueLiteral)
 			if err != nil {
@@ -583,124 +785,260 @@ ruledefault:
 				rv: elem,
 			}
 		}
-	case 9:
-		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:112
+	case 13:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:162
 		{
-			ruleVAL.rule = ruleDollar[1].rule
+			g, err := parseGlobToken(ruleDollar[2].operator, ruleDollar[3].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = &nodeGlob{lv: ruleDollar[1].rule, op: ruleDollar[2].operator, rv: g}
 		}
-	case 10:
+	case 14:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:117
+//line parser.y:172
 		{
-			ruleVAL.rule = &nodeIn{
-				lv: ruleDollar[1].rule,
-				rv: ruleDollar[3].rule,
+			if err := validateOperands(op_IN, ruleDollar[1].rule, ruleDollar[3].rule); err != nil {
+				rulelex.Error(err.Error())
+				return 1
 			}
+			ruleVAL.rule = newInNode(ruleDollar[1].rule, ruleDollar[3].rule)
 		}
-	case 11:
+	case 15:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:181
+		{
+			if err := validateOperands(ruleDollar[2].operator, ruleDollar[1].rule, ruleDollar[3].rule); err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = &nodeArith{lv: ruleDollar[1].rule, op: ruleDollar[2].operator, rv: ruleDollar[3].rule}
+		}
+	case 16:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:189
+		{
+			if err := validateOperands(ruleDollar[2].operator, ruleDollar[1].rule, ruleDollar[3].rule); err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = &nodeArith{lv: ruleDollar[1].rule, op: ruleDollar[2].operator, rv: ruleDollar[3].rule}
+		}
+	case 17:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:198
+		{
+			if err := validateOperands(op_RANGE, ruleDollar[1].rule, ruleDollar[3].rule); err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = &nodeRange{low: ruleDollar[1].rule, high: ruleDollar[3].rule}
+		}
+	case 18:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:125
+//line parser.y:207
+		{
+			ruleVAL.rule = &nodeCoalesce{left: ruleDollar[1].rule, right: ruleDollar[3].rule}
+		}
+	case 19:
+		ruleDollar = ruleS[rulept-2 : rulept+1]
+//line parser.y:212
 		{
-			v, err := parseValueToken(token_IP_CIDR, ruleDollar[3].valueLiteral)
+			ruleVAL.rule = &nodeExists{right: ruleDollar[2].rule}
+		}
+	case 20:
+		ruleDollar = ruleS[rulept-4 : rulept+1]
+//line parser.y:217
+		{
+			if err := validateIndex(ruleDollar[3].rule); err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = &nodeIndex{lv: ruleDollar[1].rule, index: ruleDollar[3].rule}
+		}
+	case 21:
+		ruleDollar = ruleS[rulept-3 : rulept+1]
+//line parser.y:227
+		{
+			fv, err := newPipe(ruleDollar[1].rule, string(ruleDollar[3].valueLiteral), nil)
 			if err != nil {
 				rulelex.Error(err.Error())
 				return 1
 			}
-
-			ruleVAL.rule = &nodeCompare{
-				lv: ruleDollar[1].rule,
-				op: op_EQ,
-				rv: v,
+			ruleVAL.rule = fv
+		}
+	case 22:
+		ruleDollar = ruleS[rulept-6 : rulept+1]
+//line parser.y:236
+		{
+			fv, err := newPipe(ruleDollar[1].rule, string(ruleDollar[3].valueLiteral), ruleDollar[5].arrayValue)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
 			}
+			ruleVAL.rule = fv
 		}
-	case 12:
+	case 23:
+		ruleDollar = ruleS[rulept-7 : rulept+1]
+//line parser.y:246
+		{
+			ruleVAL.rule = newQuantifier(string(ruleDollar[1].valueLiteral), string(ruleDollar[2].valueLiteral), ruleDollar[4].rule, ruleDollar[6].rule)
+		}
+	case 24:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:141
+//line parser.y:250
+		{
+			ruleVAL.rule = ruleDollar[1].rule
+		}
+	case 25:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:256
 		{
 			ruleVAL.operator = op_GT
 		}
-	case 13:
+	case 26:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:142
+//line parser.y:257
 		{
 			ruleVAL.operator = op_GE
 		}
-	case 14:
+	case 27:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:143
+//line parser.y:258
 		{
 			ruleVAL.operator = op_LT
 		}
-	case 15:
+	case 28:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:144
+//line parser.y:259
 		{
 			ruleVAL.operator = op_LE
 		}
-	case 16:
+	case 29:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:148
+//line parser.y:263
 		{
 			ruleVAL.operator = op_EQ
 		}
-	case 17:
+	case 30:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:149
+//line parser.y:264
 		{
 			ruleVAL.operator = op_NE
 		}
-	case 18:
+	case 31:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:150
+//line parser.y:265
 		{
 			ruleVAL.operator = op_CONTAINS
 		}
-	case 19:
+	case 32:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:266
+		{
+			ruleVAL.operator = op_IEQ
+		}
+	case 33:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:267
+		{
+			ruleVAL.operator = op_INE
+		}
+	case 34:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:268
+		{
+			ruleVAL.operator = op_ICONTAINS
+		}
+	case 35:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:156
+//line parser.y:272
+		{
+			ruleVAL.operator = op_GLOB
+		}
+	case 36:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:273
+		{
+			ruleVAL.operator = op_HOSTGLOB
+		}
+	case 37:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:277
+		{
+			ruleVAL.operator = op_ADD
+		}
+	case 38:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:278
+		{
+			ruleVAL.operator = op_SUB
+		}
+	case 39:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:282
+		{
+			ruleVAL.operator = op_MUL
+		}
+	case 40:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:283
+		{
+			ruleVAL.operator = op_DIV
+		}
+	case 41:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:284
+		{
+			ruleVAL.operator = op_MOD
+		}
+	case 42:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:290
 		{
 			ruleVAL.arrayValue = []Rule{ruleDollar[1].rule}
 		}
-	case 20:
+	case 43:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:160
+//line parser.y:294
 		{
 			ruleVAL.arrayValue = append(ruleDollar[1].arrayValue, ruleDollar[3].rule)
 		}
-	case 21:
+	case 44:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:167
+//line parser.y:301
 		{
 			ruleVAL.rule = newArrayValue(ruleDollar[2].arrayValue)
 		}
-	case 22:
+	case 45:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:173
+//line parser.y:307
 		{
 			ruleVAL.rule = ruleDollar[1].rule
 		}
-	case 23:
+	case 46:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:174
+//line parser.y:308
 		{
 			ruleVAL.rule = ruleDollar[1].rule
 		}
-	case 24:
+	case 47:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:175
+//line parser.y:309
 		{
 			ruleVAL.rule = ruleDollar[1].rule
 		}
-	case 25:
+	case 48:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:180
+//line parser.y:314
 		{
 			ruleVAL.rule = ruleDollar[1].rule
 		}
-	case 26:
+	case 49:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:182
+//line parser.y:316
 		{
 			v, err := parseValueToken(token_STRING, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -709,9 +1047,9 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 27:
+	case 50:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:191
+//line parser.y:325
 		{
 			v, err := parseValueToken(token_BOOL, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -720,9 +1058,9 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 28:
+	case 51:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:200
+//line parser.y:334
 		{
 			v, err := parseValueToken(token_IP, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -731,9 +1069,9 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 29:
+	case 52:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:209
+//line parser.y:343
 		{
 			v, err := parseValueToken(token_IP_CIDR, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -742,9 +1080,9 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 30:
+	case 53:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:218
+//line parser.y:352
 		{
 			v, err := parseValueToken(token_HEX_STRING, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -753,9 +1091,9 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 31:
+	case 54:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:227
+//line parser.y:361
 		{
 			v, err := parseValueToken(token_REGEX, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -764,9 +1102,86 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 32:
+	case 55:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:370
+		{
+			v, err := parseValueToken(token_DURATION, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 56:
+		ruleDollar = ruleS[rulept-2 : rulept+1]
+//line parser.y:379
+		{
+			v, err := parseValueToken(token_DURATION, append([]byte("-"), ruleDollar[2].valueLiteral...))
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 57:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:388
+		{
+			v, err := parseValueToken(token_NULL, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 58:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:397
+		{
+			v, err := parseValueToken(token_MAC, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 59:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:406
+		{
+			v, err := parseValueToken(token_MAC_PREFIX, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 60:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:239
+//line parser.y:415
+		{
+			v, err := parseValueToken(token_SEMVER, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 61:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:424
+		{
+			v, err := parseValueToken(token_TIMESTAMP, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 62:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:436
 		{
 			v, err := parseValueToken(token_INT, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -775,9 +1190,9 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 33:
+	case 63:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:248
+//line parser.y:445
 		{
 			v, err := parseValueToken(token_FLOAT, ruleDollar[1].valueLiteral)
 			if err != nil {
@@ -786,23 +1201,89 @@ ruledefault:
 			}
 			ruleVAL.rule = v
 		}
-	case 34:
+	case 64:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:257
+//line parser.y:454
+		{
+			v, err := parseValueToken(token_BYTES, ruleDollar[1].valueLiteral)
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 65:
+		ruleDollar = ruleS[rulept-2 : rulept+1]
+//line parser.y:464
+		{
+			v, err := parseValueToken(token_INT, append([]byte("-"), ruleDollar[2].valueLiteral...))
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 66:
+		ruleDollar = ruleS[rulept-2 : rulept+1]
+//line parser.y:473
+		{
+			v, err := parseValueToken(token_INT, append([]byte("+"), ruleDollar[2].valueLiteral...))
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 67:
+		ruleDollar = ruleS[rulept-2 : rulept+1]
+//line parser.y:482
+		{
+			v, err := parseValueToken(token_FLOAT, append([]byte("-"), ruleDollar[2].valueLiteral...))
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 68:
+		ruleDollar = ruleS[rulept-2 : rulept+1]
+//line parser.y:491
+		{
+			v, err := parseValueToken(token_FLOAT, append([]byte("+"), ruleDollar[2].valueLiteral...))
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = v
+		}
+	case 69:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:500
 		{
 			ruleVAL.rule = FieldValue(string(ruleDollar[1].valueLiteral))
 		}
-	case 35:
+	case 70:
+		ruleDollar = ruleS[rulept-1 : rulept+1]
+//line parser.y:504
+		{
+			path, err := parseFieldPath(string(ruleDollar[1].valueLiteral))
+			if err != nil {
+				rulelex.Error(err.Error())
+				return 1
+			}
+			ruleVAL.rule = path
+		}
+	case 71:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:261
+//line parser.y:513
 		{
 			// there is no syntatic difference between a function call and a field name
 			// so an isolated function name is treated as a field name
 			ruleVAL.rule = FieldValue(string(ruleDollar[1].valueLiteral))
 		}
-	case 36:
+	case 72:
 		ruleDollar = ruleS[rulept-4 : rulept+1]
-//line parser.y:270
+//line parser.y:522
 		{
 			fv := newFunctionValue(string(ruleDollar[1].valueLiteral), ruleDollar[3].arrayValue)
 			if err := fv.ValidateStdlibFnArgs(); err != nil {
@@ -813,21 +1294,21 @@ ruledefault:
 			}
 			ruleVAL.rule = fv
 		}
-	case 37:
+	case 73:
 		ruleDollar = ruleS[rulept-1 : rulept+1]
-//line parser.y:284
+//line parser.y:537
 		{
 			ruleVAL.arrayValue = []Rule{ruleDollar[1].rule}
 		}
-	case 38:
+	case 74:
 		ruleDollar = ruleS[rulept-3 : rulept+1]
-//line parser.y:288
+//line parser.y:541
 		{
 			ruleVAL.arrayValue = append(ruleDollar[1].arrayValue, ruleDollar[3].rule)
 		}
-	case 39:
+	case 75:
 		ruleDollar = ruleS[rulept-0 : rulept+1]
-//line parser.y:292
+//line parser.y:545
 		{
 			ruleVAL.arrayValue = ([]Rule)(nil)
 		}
@@ -3,11 +3,14 @@ package rulekit
 import (
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 )
 
 var ruleDebugWriter io.Writer = os.Stderr
@@ -46,26 +49,41 @@ func operatorToString(op int) string {
 		return "<="
 	case op_CONTAINS:
 		return "contains"
+	case op_IEQ:
+		return "ieq"
+	case op_INE:
+		return "ine"
+	case op_ICONTAINS:
+		return "icontains"
 	case op_MATCHES:
 		return "matches"
 	case op_IN:
 		return "in"
+	case op_RANGE:
+		return ".."
+	case op_GLOB:
+		return "glob"
+	case op_HOSTGLOB:
+		return "hostglob"
+	case op_EXISTS:
+		return "exists"
+	case op_ADD:
+		return "+"
+	case op_SUB:
+		return "-"
+	case op_MUL:
+		return "*"
+	case op_DIV:
+		return "/"
+	case op_MOD:
+		return "%"
 	default:
 		return "unknown"
 	}
 }
 
 func parseString[T interface{ string | []byte }](data T) (any, error) {
-	str := string(data)
-	if str[0] == '\'' {
-		// Convert single-quoted string to double-quoted
-		str = str[1 : len(str)-1]
-		str = strings.ReplaceAll(str, `"`, "\\\"")
-		str = strings.ReplaceAll(str, `\'`, `'`)
-		str = `"` + str + `"`
-	}
-	var err error
-	str, err = strconv.Unquote(str)
+	str, err := unquoteString(data)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +119,39 @@ func parseFloat[T interface{ string | []byte }](data T) (float64, error) {
 	return strconv.ParseFloat(string(data), 64)
 }
 
+var byteUnits = map[string]int64{
+	"B":   1,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"TB":  1e12,
+	"PB":  1e15,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+	"TiB": 1 << 40,
+	"PiB": 1 << 50,
+}
+
+// parseBytes parses a byte size such as 10KB, 5MiB or 1.5GB as an integer number of bytes.
+func parseBytes[T interface{ string | []byte }](data T) (any, error) {
+	raw := string(data)
+	i := strings.IndexFunc(raw, unicode.IsLetter)
+	unit, ok := byteUnits[raw[i:]]
+	if !ok {
+		return nil, fmt.Errorf("parsing byte size: invalid unit %q", raw[i:])
+	}
+	n, ok := new(big.Rat).SetString(strings.ReplaceAll(raw[:i], "_", ""))
+	if !ok {
+		return nil, fmt.Errorf("parsing byte size: invalid value %q", raw)
+	}
+	n.Mul(n, new(big.Rat).SetInt64(unit))
+	if !n.IsInt() {
+		return nil, fmt.Errorf("parsing byte size: %q is not a whole number of bytes", raw)
+	}
+	return parseInt(n.Num().String())
+}
+
 func parseBool[T interface{ string | []byte }](data T) (bool, error) {
 	var val bool
 	_, err := fmt.Sscanf(string(data), "%t", &val)
@@ -109,10 +160,148 @@ func parseBool[T interface{ string | []byte }](data T) (bool, error) {
 
 func parseRegex[T interface{ string | []byte }](data T) (*regexp.Regexp, error) {
 	raw := string(data)
-	pattern := raw[1 : len(raw)-1] // Remove the forward slashes
+	// remove the delimiters and translate any trailing flags, e.g. /pattern/i -> (?i)pattern
+	end := strings.LastIndexByte(raw, raw[0])
+	pattern, flags := raw[1:end], raw[end+1:]
+	if flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
 	return regexp.Compile(pattern)
 }
 
+// mixedAndOrWarnings returns a warning for every `and` or `or` that follows the other operator without
+// parentheses in between, e.g. `a and b or c and d` has two. Groups of tokens are delimited by parentheses,
+// brackets, commas and conditional operators. str must be a valid rule.
+func mixedAndOrWarnings(str string) []Warning {
+	type group struct {
+		logicalOp int // the previous and/or operator in the group
+	}
+	var (
+		warnings []Warning
+		stack    = []group{{}}
+		lexer    = newLex([]byte(str))
+		lval     ruleSymType
+	)
+	for {
+		tok := lexer.Lex(&lval)
+		if tok == 0 || tok == token_ERROR {
+			return warnings
+		}
+		cur := &stack[len(stack)-1]
+		switch tok {
+		case token_LPAREN, token_LBRACKET, token_QUANTIFIER:
+			// quantifier tokens include the opening parenthesis, e.g. `all(`
+			stack = append(stack, group{})
+		case token_RPAREN, token_RBRACKET:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case token_COMMA, op_QUESTION, op_COLON, op_IF, op_THEN, op_ELSE:
+			*cur = group{}
+		case op_AND, op_OR:
+			if cur.logicalOp != 0 && cur.logicalOp != tok {
+				line, col := getLineColumn(str, lexer.ts)
+				warnings = append(warnings, Warning{
+					Line:    line,
+					Column:  col,
+					Message: "and/or mixed without parentheses",
+				})
+			}
+			cur.logicalOp = tok
+		}
+	}
+}
+
+// newPipe desugars `lv | fn(args...)` into the function call fn(lv, args...).
+func newPipe(lv Rule, fn string, args []Rule) (*FunctionValue, error) {
+	fv := newFunctionValue(fn, append([]Rule{lv}, args...))
+	if err := fv.ValidateStdlibFnArgs(); err != nil {
+		return nil, err
+	}
+	return fv, nil
+}
+
+// parseFieldPath parses a field path containing backtick-quoted or wildcard segments,
+// e.g. labels.`k8s.io/app`, items[*].name or items.*.name.
+// A backslash escapes the following character inside a quoted segment.
+func parseFieldPath(raw string) (FieldPath, error) {
+	var path FieldPath
+	for i := 0; ; i++ {
+		switch {
+		case i < len(raw) && raw[i] == '`':
+			var b strings.Builder
+			for i++; i < len(raw) && raw[i] != '`'; i++ {
+				if raw[i] == '\\' && i+1 < len(raw) {
+					i++
+				}
+				b.WriteByte(raw[i])
+			}
+			if i == len(raw) {
+				return nil, fmt.Errorf("unterminated quoted field %s", raw)
+			}
+			path = append(path, PathSegment{Key: b.String()})
+			i++
+		case i < len(raw) && raw[i] == '*':
+			path = append(path, PathSegment{Wildcard: true})
+			i++
+		default:
+			end := strings.IndexAny(raw[i:], ".[")
+			if end == -1 {
+				end = len(raw) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid field %s", raw)
+			}
+			path = append(path, PathSegment{Key: raw[i : i+end]})
+			i += end
+		}
+		for strings.HasPrefix(raw[i:], "[*]") {
+			path = append(path, PathSegment{Wildcard: true})
+			i += 3
+		}
+		if i == len(raw) {
+			return path, nil
+		}
+		if raw[i] != '.' {
+			return nil, fmt.Errorf("invalid field %s", raw)
+		}
+	}
+}
+
+// unquoteString returns the contents of a single- or double-quoted string literal.
+func unquoteString[T interface{ string | []byte }](data T) (string, error) {
+	str := string(data)
+	if str[0] == '\'' {
+		// Convert single-quoted string to double-quoted
+		str = str[1 : len(str)-1]
+		str = strings.ReplaceAll(str, `"`, "\\\"")
+		str = strings.ReplaceAll(str, `\'`, `'`)
+		str = `"` + str + `"`
+	}
+	return strconv.Unquote(str)
+}
+
+// parseGlobToken parses a quoted glob pattern for the glob or hostglob operator.
+func parseGlobToken(op int, rawBytes []byte) (Rule, error) {
+	str, err := unquoteString(rawBytes)
+	if err != nil {
+		return nil, err
+	}
+	var g Glob
+	if op == op_HOSTGLOB {
+		g, err = ParseHostGlob(str)
+	} else {
+		g, err = ParsePathGlob(str)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &LiteralValue[any]{
+		raw:   string(rawBytes),
+		value: g,
+	}, nil
+}
+
 func parseValueToken(typ int, rawBytes []byte) (Rule, error) {
 	raw := string(rawBytes)
 	var (
@@ -126,6 +315,8 @@ func parseValueToken(typ int, rawBytes []byte) (Rule, error) {
 		value, err = parseInt(raw)
 	case token_FLOAT:
 		value, err = parseFloat(raw)
+	case token_BYTES:
+		value, err = parseBytes(raw)
 	case token_BOOL:
 		value, err = parseBool(raw)
 	case token_IP:
@@ -134,8 +325,20 @@ func parseValueToken(typ int, rawBytes []byte) (Rule, error) {
 		_, value, err = net.ParseCIDR(raw)
 	case token_HEX_STRING:
 		value, err = ParseHexString(raw)
+	case token_MAC:
+		value, err = net.ParseMAC(raw)
+	case token_MAC_PREFIX:
+		value, err = ParseMACPrefix(raw)
 	case token_REGEX:
 		value, err = parseRegex(raw)
+	case token_DURATION:
+		value, err = time.ParseDuration(raw)
+	case token_TIMESTAMP:
+		value, err = time.Parse(time.RFC3339Nano, raw)
+	case token_SEMVER:
+		value, err = ParseSemver(raw)
+	case token_NULL:
+		value = nil
 	default:
 		err = fmt.Errorf("unknown parseValueToken type")
 	}
@@ -156,6 +359,8 @@ func valueTokenString(typ int) string {
 		return "integer"
 	case token_FLOAT:
 		return "float"
+	case token_BYTES:
+		return "byte size"
 	case token_BOOL:
 		return "boolean"
 	case token_IP:
@@ -164,8 +369,20 @@ func valueTokenString(typ int) string {
 		return "CIDR"
 	case token_HEX_STRING:
 		return "hex string"
+	case token_MAC:
+		return "MAC"
+	case token_MAC_PREFIX:
+		return "MAC prefix"
 	case token_REGEX:
 		return "regex"
+	case token_DURATION:
+		return "duration"
+	case token_TIMESTAMP:
+		return "timestamp"
+	case token_SEMVER:
+		return "semver"
+	case token_NULL:
+		return "null"
 	case token_FIELD:
 		return "field identifier"
 	case token_FUNCTION:
@@ -194,3 +411,157 @@ type ValueParseError struct {
 func (e ValueParseError) Error() string {
 	return fmt.Sprintf("parsing %s value %q: %v", valueTokenString(e.TokenType), e.Value, e.Err)
 }
+
+// validateOperands returns an error if a literal operand can never satisfy the operator, e.g. `f > "str"`.
+// Non-literal operands such as fields and function calls are only known at evaluation time.
+func validateOperands(op int, lv Rule, rv Rule) error {
+	switch op {
+	case op_GT, op_GE, op_LT, op_LE:
+		// arrays compare each element
+		if err := validateLiteral(op, lv, true, isOrdered); err != nil {
+			return err
+		}
+		return validateLiteral(op, rv, true, isOrdered)
+
+	case op_ADD, op_SUB, op_MUL, op_DIV, op_MOD:
+		if err := validateLiteral(op, lv, false, isArithmetic); err != nil {
+			return err
+		}
+		return validateLiteral(op, rv, false, isArithmetic)
+
+	case op_RANGE:
+		// range bounds must be ordered
+		isBound := func(v any) bool {
+			_, ok := v.(net.IP)
+			return ok || isOrdered(v)
+		}
+		if err := validateLiteral(op, lv, false, isBound); err != nil {
+			return err
+		}
+		return validateLiteral(op, rv, false, isBound)
+
+	case op_IN:
+		// the right value must be an array, a CIDR, a MAC prefix or a range
+		return validateLiteral(op, rv, true, func(v any) bool {
+			switch v.(type) {
+			case *net.IPNet, MACPrefix:
+				return true
+			}
+			return false
+		})
+	}
+	return nil
+}
+
+func validateLiteral(op int, r Rule, allowArray bool, valid func(any) bool) error {
+	switch r := r.(type) {
+	case *LiteralValue[any]:
+		if !valid(r.value) {
+			return fmt.Errorf("%w: operator %s not defined on %s value %s", ErrInvalidOperation, operatorToString(op), literalTypeName(r.value), r.raw)
+		}
+	case *nodeCond:
+		// either branch may be chosen
+		if err := validateLiteral(op, r.then, allowArray, valid); err != nil {
+			return err
+		}
+		return validateLiteral(op, r.els, allowArray, valid)
+	case *nodeCoalesce:
+		// the default value may be chosen
+		return validateLiteral(op, r.right, allowArray, valid)
+	case *ArrayValue:
+		if !allowArray {
+			return fmt.Errorf("%w: operator %s not defined on array value %s", ErrInvalidOperation, operatorToString(op), r.raw)
+		}
+		if op == op_IN {
+			// any array may appear on the right side of `in`
+			return nil
+		}
+		for _, v := range r.vals {
+			// nested arrays are compared element-wise like the outer array
+			if err := validateLiteral(op, v, allowArray, valid); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isOrdered reports whether v supports inequality operators.
+func isOrdered(v any) bool {
+	switch v.(type) {
+	case int, int64, uint, uint64, float32, float64, time.Time, time.Duration, Semver:
+		return true
+	}
+	return false
+}
+
+// isArithmetic reports whether v supports arithmetic operators.
+func isArithmetic(v any) bool {
+	_, ok := v.(Semver)
+	return !ok && isOrdered(v)
+}
+
+func literalTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case int64, uint64:
+		return "integer"
+	case float64:
+		return "float"
+	case bool:
+		return "boolean"
+	case net.IP:
+		return "IP"
+	case *net.IPNet:
+		return "CIDR"
+	case HexString:
+		return "hex string"
+	case net.HardwareAddr:
+		return "MAC"
+	case MACPrefix:
+		return "MAC prefix"
+	case *regexp.Regexp:
+		return "regex"
+	case time.Duration:
+		return "duration"
+	case time.Time:
+		return "timestamp"
+	case Semver:
+		return "semver"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// newInNode returns the node for `lv in rv`.
+func newInNode(lv Rule, rv Rule) Rule {
+	if l, ok := rv.(*LiteralValue[any]); ok {
+		switch l.value.(type) {
+		case *net.IPNet, MACPrefix:
+			// `ip in CIDR` == `ip == CIDR`, likewise for MAC prefixes
+			return &nodeCompare{
+				lv: lv,
+				op: op_EQ,
+				rv: rv,
+			}
+		}
+	}
+	return &nodeIn{
+		lv: lv,
+		rv: rv,
+	}
+}
+
+// validateIndex returns an error if a literal subscript can never index a map or an array.
+func validateIndex(index Rule) error {
+	if l, ok := index.(*LiteralValue[any]); ok {
+		switch l.value.(type) {
+		case string, int64, uint64:
+		default:
+			return fmt.Errorf("%w: cannot index with %s value %s", ErrInvalidOperation, literalTypeName(l.value), l.raw)
+		}
+	}
+	return nil
+}
@@ -10,9 +10,10 @@ package rulekit
 }
 
 // Type declarations for non-terminals (rules)
-%type <rule> search_condition predicate
+%type <rule> search_condition expr
 %type <rule> function_call
-%type <operator> ineq_operator eq_operator
+%type <operator> ineq_operator eq_operator glob_operator
+%type <operator> add_operator mul_operator
 %type <arrayValue> array_values
 // value tokens
 %type <rule> value_token // all values
@@ -29,55 +30,99 @@ package rulekit
 %token <valueLiteral> token_IP_CIDR
 %token <valueLiteral> token_IP
 %token <valueLiteral> token_REGEX
+%token <valueLiteral> token_DURATION token_TIMESTAMP
+%token <valueLiteral> token_SEMVER
+%token <valueLiteral> token_BYTES
+%token <valueLiteral> token_MAC token_MAC_PREFIX
+%token <valueLiteral> token_FIELD_PATH
+%token <valueLiteral> token_NULL
+%token <valueLiteral> token_QUANTIFIER
 
 // Tokens without values
 %token op_NOT op_AND op_OR
+%token op_CONV_AND op_CONV_OR
 %token token_LPAREN token_RPAREN
 %token token_LBRACKET token_RBRACKET
 %token token_COMMA
 %token op_EQ op_NE
 %token op_GT op_GE op_LT op_LE
-%token op_CONTAINS op_MATCHES op_IN
+%token op_CONTAINS op_MATCHES op_IN op_EXISTS
+%token op_IEQ op_INE op_ICONTAINS
+%token op_GLOB op_HOSTGLOB
+%token op_RANGE
+%token op_PIPE
+%token op_ADD op_SUB op_MUL op_DIV op_MOD
+%token op_QUESTION op_COLON op_IF op_THEN op_ELSE op_COALESCE
 %token token_ARRAY
 %token token_ERROR
 
 // Operator precedence
-%left op_AND
-%left op_OR
+%right op_QUESTION op_COLON
+// by default `or` binds tighter than `and`. with WithConventionalPrecedence the lexer
+// emits op_CONV_AND and op_CONV_OR instead, which give `and` the higher precedence.
+%left op_AND op_CONV_OR
+%left op_OR op_CONV_AND
 %right op_NOT
+%nonassoc op_EQ op_NE op_GT op_GE op_LT op_LE op_CONTAINS op_MATCHES op_IN op_IEQ op_INE op_ICONTAINS op_GLOB op_HOSTGLOB
+// the else branch of `if c then a else b` binds tighter than comparisons so that
+// `if tls then 443 else 80 == port` compares the chosen value
+%nonassoc op_ELSE
+// `url | lower == "x"` compares the result of the pipeline
+%left op_PIPE
+// `port in 1024..65535`
+%nonassoc op_RANGE
+// `retries ?? 0 < 3` compares the coalesced value
+%right op_COALESCE
+%left op_ADD op_SUB
+%left op_MUL op_DIV op_MOD
+%right op_EXISTS
+%left token_LBRACKET
 
 %%
 search_condition:
-	predicate
+	expr
 	{
 		$$ = $1
 		rulelex.Result($$)
 	}
-	| search_condition op_AND search_condition
+	;
+
+expr:
+	expr op_AND expr
 	{
 		$$ = &nodeAnd{left: $1, right: $3}
-		rulelex.Result($$)
 	}
-	| search_condition op_OR search_condition
+	| expr op_OR expr
 	{
 		$$ = &nodeOr{left: $1, right: $3}
-		rulelex.Result($$)
 	}
-	| op_NOT search_condition
+	| expr op_CONV_AND expr
+	{
+		$$ = &nodeAnd{left: $1, right: $3}
+	}
+	| expr op_CONV_OR expr
+	{
+		$$ = &nodeOr{left: $1, right: $3}
+	}
+	| op_NOT expr
 	{
 		$$ = &nodeNot{right: $2}
-		rulelex.Result($$)
 	}
-	| token_LPAREN search_condition token_RPAREN
+	| token_LPAREN expr token_RPAREN
 	{
 		$$ = $2
-		rulelex.Result($$)
 	}
-	;
-
-predicate:
-	// numeric values accept additional inequality operators
-	numeric_value_token ineq_operator numeric_value_token
+	// conditional values
+	| expr op_QUESTION expr op_COLON expr
+	{
+		$$ = &nodeCond{cond: $1, then: $3, els: $5, ternary: true}
+	}
+	| op_IF expr op_THEN expr op_ELSE expr
+	{
+		$$ = &nodeCond{cond: $2, then: $4, els: $6}
+	}
+	// all values accept equality operators
+	| expr eq_operator expr %prec op_EQ
 	{
 		$$ = &nodeCompare{
 			lv: $1,
@@ -85,9 +130,13 @@ predicate:
 			rv: $3,
 		}
 	}
-	// all values including numeric accept equality operators
-	| array_or_single_value_token eq_operator array_or_single_value_token
+	// inequality operators only accept values that may be numeric
+	| expr ineq_operator expr %prec op_GT
 	{
+		if err := validateOperands($2, $1, $3); err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
 		$$ = &nodeCompare{
 			lv: $1,
 			op: $2,
@@ -95,7 +144,7 @@ predicate:
 		}
 	}
 	// op_MATCHES supports regex values
-	| array_or_single_value_token op_MATCHES token_REGEX
+	| expr op_MATCHES token_REGEX
 	{
 		elem, err := parseValueToken(token_REGEX, $3)
 		if err != nil {
@@ -108,32 +157,98 @@ predicate:
 			rv: elem,
 		}
 	}
-	| array_or_single_value_token
+	// glob patterns are compiled at parse time
+	| expr glob_operator token_STRING
 	{
-		$$ = $1
+		g, err := parseGlobToken($2, $3)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = &nodeGlob{lv: $1, op: $2, rv: g}
 	}
-	// op_IN supports array values
-	| array_or_single_value_token op_IN array_value_token
+	// op_IN supports arrays, IP CIDR values and any value-producing expression
+	| expr op_IN expr
 	{
-		$$ = &nodeIn{
-			lv: $1,
-			rv: $3,
+		if err := validateOperands(op_IN, $1, $3); err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = newInNode($1, $3)
+	}
+	// arithmetic
+	| expr add_operator expr %prec op_ADD
+	{
+		if err := validateOperands($2, $1, $3); err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = &nodeArith{lv: $1, op: $2, rv: $3}
+	}
+	| expr mul_operator expr %prec op_MUL
+	{
+		if err := validateOperands($2, $1, $3); err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = &nodeArith{lv: $1, op: $2, rv: $3}
+	}
+	// inclusive ranges, e.g. 1024..65535
+	| expr op_RANGE expr
+	{
+		if err := validateOperands(op_RANGE, $1, $3); err != nil {
+			rulelex.Error(err.Error())
+			return 1
 		}
+		$$ = &nodeRange{low: $1, high: $3}
+	}
+	// default values for missing fields, e.g. region ?? "us-east-1"
+	| expr op_COALESCE expr
+	{
+		$$ = &nodeCoalesce{left: $1, right: $3}
 	}
-	// op_IN supports IP CIDR values
-	| array_or_single_value_token op_IN token_IP_CIDR
+	// exists never returns a missing fields error
+	| op_EXISTS expr
 	{
-		v, err := parseValueToken(token_IP_CIDR, $3)
+		$$ = &nodeExists{right: $2}
+	}
+	// index and key access, e.g. headers["Content-Type"] or args[0]
+	| expr token_LBRACKET expr token_RBRACKET
+	{
+		if err := validateIndex($3); err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = &nodeIndex{lv: $1, index: $3}
+	}
+	// pipes pass the left operand as the first argument of a function,
+	// e.g. url | lower | trim or url | starts_with("https://")
+	| expr op_PIPE token_FUNCTION
+	{
+		fv, err := newPipe($1, string($3), nil)
 		if err != nil {
 			rulelex.Error(err.Error())
 			return 1
 		}
-
-		$$ = &nodeCompare{
-			lv: $1,
-			op: op_EQ,
-			rv: v,
+		$$ = fv
+	}
+	| expr op_PIPE token_FUNCTION token_LPAREN function_arguments token_RPAREN
+	{
+		fv, err := newPipe($1, string($3), $5)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
 		}
+		$$ = fv
+	}
+	// quantifiers, e.g. all(x in ips, x in 10.0.0.0/8)
+	| token_QUANTIFIER token_FUNCTION op_IN expr token_COMMA expr token_RPAREN
+	{
+		$$ = newQuantifier(string($1), string($2), $4, $6)
+	}
+	| array_or_single_value_token
+	{
+		$$ = $1
 	}
 	;
 
@@ -148,15 +263,34 @@ eq_operator:
 	op_EQ         { $$ = op_EQ       }
 	| op_NE       { $$ = op_NE       }
 	| op_CONTAINS { $$ = op_CONTAINS }
+	| op_IEQ       { $$ = op_IEQ       }
+	| op_INE       { $$ = op_INE       }
+	| op_ICONTAINS { $$ = op_ICONTAINS }
 	;
 
-// Array handling rules
+glob_operator:
+	op_GLOB       { $$ = op_GLOB     }
+	| op_HOSTGLOB { $$ = op_HOSTGLOB }
+	;
+
+add_operator:
+	op_ADD   { $$ = op_ADD }
+	| op_SUB { $$ = op_SUB }
+	;
+
+mul_operator:
+	op_MUL   { $$ = op_MUL }
+	| op_DIV { $$ = op_DIV }
+	| op_MOD { $$ = op_MOD }
+	;
+
+// Array handling rules. elements may be any expression, including nested arrays
 array_values:
-	value_token
+	expr
 	{
 		$$ = []Rule{$1}
 	}
-	| array_values token_COMMA value_token
+	| array_values token_COMMA expr
 	{
 		$$ = append($1, $3)
 	}
@@ -232,6 +366,69 @@ value_token:
 		}
 		$$ = v
 	}
+	| token_DURATION
+	{
+		v, err := parseValueToken(token_DURATION, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| op_SUB token_DURATION
+	{
+		v, err := parseValueToken(token_DURATION, append([]byte("-"), $2...))
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| token_NULL
+	{
+		v, err := parseValueToken(token_NULL, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| token_MAC
+	{
+		v, err := parseValueToken(token_MAC, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| token_MAC_PREFIX
+	{
+		v, err := parseValueToken(token_MAC_PREFIX, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| token_SEMVER
+	{
+		v, err := parseValueToken(token_SEMVER, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| token_TIMESTAMP
+	{
+		v, err := parseValueToken(token_TIMESTAMP, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
 	;
 
 numeric_value_token:
@@ -253,10 +450,65 @@ numeric_value_token:
 		}
 		$$ = v
 	}
+	| token_BYTES
+	{
+		v, err := parseValueToken(token_BYTES, $1)
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	// signed literals, e.g. -1 or +1.5
+	| op_SUB token_INT
+	{
+		v, err := parseValueToken(token_INT, append([]byte("-"), $2...))
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| op_ADD token_INT
+	{
+		v, err := parseValueToken(token_INT, append([]byte("+"), $2...))
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| op_SUB token_FLOAT
+	{
+		v, err := parseValueToken(token_FLOAT, append([]byte("-"), $2...))
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
+	| op_ADD token_FLOAT
+	{
+		v, err := parseValueToken(token_FLOAT, append([]byte("+"), $2...))
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = v
+	}
 	| token_FIELD
 	{
 		$$ = FieldValue(string($1))
 	}
+	| token_FIELD_PATH
+	{
+		path, err := parseFieldPath(string($1))
+		if err != nil {
+			rulelex.Error(err.Error())
+			return 1
+		}
+		$$ = path
+	}
 	| token_FUNCTION
 	{
 		// there is no syntatic difference between a function call and a field name
@@ -279,12 +531,13 @@ function_call:
 	}
 	;
 
+// arguments may be any expression, e.g. count_if(items, x > 5)
 function_arguments:
-	array_or_single_value_token
+	expr
 	{
 		$$ = []Rule{$1}
 	}
-	| function_arguments token_COMMA array_or_single_value_token
+	| function_arguments token_COMMA expr
 	{
 		$$ = append($1, $3)
 	}
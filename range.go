@@ -0,0 +1,85 @@
+package rulekit
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Range is an inclusive range of numbers, IP addresses, semantic versions, durations or timestamps, e.g. 1024..65535 or 10.0.0.5..10.0.0.50
+type Range struct {
+	Low  any
+	High any
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("%v..%v", r.Low, r.High)
+}
+
+// Contains reports whether v is within the range, including its bounds.
+func (r Range) Contains(v any) bool {
+	lo := cmpRangeBound(r.Low, v)
+	hi := cmpRangeBound(v, r.High)
+	if lo == cmpResultNotComparable || hi == cmpResultNotComparable {
+		return false
+	}
+	return lo != cmpResultGreater && hi != cmpResultGreater
+}
+
+func compareRange(left Range, op int, right any) (ret bool) {
+	defer func() {
+		debugResult(ret, "│ cmpRange", "", left, op, right)
+	}()
+	switch op {
+	case op_CONTAINS:
+		// range contains any
+		return left.Contains(right)
+	}
+	return false
+}
+
+// cmpRangeBound compares a value against a range bound.
+func cmpRangeBound(left any, right any) int {
+	switch left.(type) {
+	case net.IP:
+		return cmpIP(left, right)
+	case time.Time, time.Duration:
+		return cmpTime(left, right)
+	case Semver:
+		return cmpSemver(left, right)
+	}
+	switch right.(type) {
+	case net.IP:
+		return reverseCmpResult(cmpIP(right, left))
+	case time.Time, time.Duration:
+		return reverseCmpResult(cmpTime(right, left))
+	case Semver:
+		return cmpSemver(left, right)
+	}
+	return cmpNumber(left, right)
+}
+
+// cmpIP orders IP addresses numerically. IPv4 addresses are ordered as IPv4-mapped IPv6 addresses.
+func cmpIP(left any, right any) int {
+	l, ok := toIP(left)
+	if !ok {
+		return cmpResultNotComparable
+	}
+	r, ok := toIP(right)
+	if !ok {
+		return cmpResultNotComparable
+	}
+	return bytes.Compare(l.To16(), r.To16())
+}
+
+func toIP(v any) (net.IP, bool) {
+	switch v := v.(type) {
+	case net.IP:
+		return v, v.To16() != nil
+	case string:
+		ip := net.ParseIP(v)
+		return ip, ip != nil
+	}
+	return nil, false
+}
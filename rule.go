@@ -26,12 +26,41 @@ package rulekit
 			- src.port == dst.port
 			- 500 > 2
 
+	Function calls and parenthesized expressions may also be used on either side of an operator.
+		For example:
+			- len(path) > 5
+			- (bytes_in + bytes_out) / duration > 1000
+
+	Function arguments and array elements may be any expression.
+		For example: f(status >= 500, [src.ip, dst.ip]), x in [a, [b, c]]
+		Arguments declared with FunctionArg.Var are passed to the function as a Predicate, e.g. count_if(items, x > 5)
+
 	A FIELD or VALUE on its own without an operator will check if the field contains a non-zero value.
 		For example: `bool_field && string_field`
 
+	Field names containing other characters may be quoted with backticks. A dot inside a quoted
+	segment is part of the key rather than a path separator.
+		For example: `2xx_count` > 0, labels.`k8s.io/app` == "web"
+
+	A wildcard segment, [*] or .*, fans out over every element of a slice or value of a map and
+	returns the flattened results as an array.
+		For example: requests[*].headers[*].name == "Host", services.*.port == 443
+
 	Supported operators:
 		== (eq), != (ne), > (gt), >= (ge), < (lt), <= (le), contains, matches, in
+		ieq, ine, icontains case-insensitive string comparisons using Unicode case folding
+		glob "/static/*.js", hostglob "*.example.com" path-style and host-style wildcard patterns
 		or (||), and (&&), not (!)
+			or binds tighter than and unless parsed WithConventionalPrecedence
+		+, -, *, /, % arithmetic on numbers
+			a leading - only forms signed number and duration literals, e.g. -1 or -5m; use 0 - field to negate
+		cond ? a : b, if cond then a else b conditional values
+		map["key"], array[0] index and key access
+		all(x in coll, pred), any(...), none(...) quantifiers over arrays and map values
+		exists field, exists(field) true if the field is present, even if nil; never returns a missing fields error
+		field ?? default the default value if the field is missing or nil
+		low..high inclusive ranges of numbers, IPs, semantic versions, durations and timestamps, e.g. port in 1024..65535
+		value | fn, value | fn(args) pipes, passing value as the first argument of fn, e.g. url | lower | trim
 		() parentheses for grouping
 
 	Supported types:
@@ -46,6 +75,10 @@ package rulekit
 
 			numbers are parsed as either int64 or uint64 if out of range for int64
 			floats are parsed as float64
+			integers may be written in hex (0x1F), octal (0o755) or binary (0b1010), floats in scientific notation (1e6),
+			and digits may be separated by underscores (1_000_000)
+			byte sizes with a B, KB, MB, GB, TB, PB, KiB, MiB, GiB, TiB or PiB suffix are parsed as an integer number of bytes, e.g. 10KB, 5MiB
+			arithmetic results follow the same rules; mixing an integer with a float yields a float64
 
 			Go type: int64, uint64, float64
 
@@ -72,8 +105,22 @@ package rulekit
 
 			Go type: *net.IPNet
 
+		MAC address: VALUE, FIELD
+			e.g. aa:bb:cc:dd:ee:ff, aa-bb-cc-dd-ee-ff, aabb.ccdd.eeff
+
+			a 6-octet MAC address in colon, dash or Cisco dotted notation. string fields are parsed as MAC addresses.
+
+			Go type: net.HardwareAddr
+
+		MAC prefix: VALUE
+			e.g. aa:bb:cc:* (OUI), aa:bb:cc/24
+
+			a range of MAC addresses sharing a prefix of at least 3 octets, e.g. mac in aa:bb:cc:*
+
+			Go type: rule.MACPrefix (mac.go)
+
 		Hexadecimal string: VALUE, FIELD
-			e.g. 12:34:56:78:ab (MAC address)
+			e.g. 12:34:56:78:ab
 			e.g. 504f5354 (hex string "POST")
 
 			a hexadecimal string, optionally separated by colons.
@@ -82,10 +129,43 @@ package rulekit
 				- FIELD: []byte
 				- VALUE: rule.HexString (hexstring.go)
 
+		Duration: VALUE, FIELD
+			e.g. 5m, 1h30m, 250ms
+
+			a Go-style duration. When compared against a number, the number is interpreted as seconds.
+
+			Go type: time.Duration
+
+		Timestamp: VALUE, FIELD
+			e.g. 2026-01-01T00:00:00Z
+
+			an RFC 3339 timestamp. When compared against a number, the number is interpreted as unix seconds.
+			the current time is returned by now(), e.g. created_at > now() - 24h
+
+			Go type: time.Time
+
+		Semantic version: VALUE
+			e.g. 1.12.0, 2.0.0-rc.1, 1.0.0+build.5
+
+			ordered by semver precedence: prereleases sort before their release and build metadata is ignored.
+			a string compared against a semver is parsed as a semantic version, optionally prefixed with "v".
+			semver(value) converts a value that can't be written as a literal, e.g. semver(version) > semver("v1.2.3")
+
+			Go type: rule.Semver (semver.go)
+
+		Null: VALUE
+			e.g. null
+
+			only equal to a nil value. comparing a missing field against null is not an error,
+			so `field == null` is true both when the field is absent and when it is nil.
+
+			Go type: nil
+
 		Regex: VALUE
 			e.g. /example\.com$/
 
 			a Go-style regular expression. Must be surrounded by forward slashes. May not be quoted with double quotes (otherwise it will be parsed as a string).
+			may be followed by the flags i (case-insensitive), s (. matches \n) and m (multi-line), e.g. /^get$/i
 
 			Go type: *regexp.Regexp
 
@@ -98,11 +178,22 @@ import (
 )
 
 // Parse parses a rule expression and returns a Rule.
-func Parse(str string) (Rule, error) {
+func Parse(str string, opts ...ParseOption) (Rule, error) {
+	var o parseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	lexer := newLex([]byte(str))
+	lexer.conventionalPrecedence = o.conventionalPrecedence
 	ok := ruleParse(lexer)
 
 	if ok == 0 {
+		if o.warn != nil {
+			for _, w := range mixedAndOrWarnings(str) {
+				o.warn(w)
+			}
+		}
 		return &rule{lexer.result}, nil
 	}
 
@@ -118,29 +209,76 @@ func Parse(str string) (Rule, error) {
 	}
 }
 
-func MustParse(str string) Rule {
-	r, err := Parse(str)
+func MustParse(str string, opts ...ParseOption) Rule {
+	r, err := Parse(str, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return r
 }
 
+// ParseOption configures how a rule is parsed.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	conventionalPrecedence bool
+	warn                   func(Warning)
+}
+
+// WithConventionalPrecedence gives `and` a higher precedence than `or`, as in SQL and C, so that
+// `a or b and c` is parsed as `a or (b and c)`. By default `or` binds tighter than `and` and the
+// same rule is parsed as `(a or b) and c`.
+func WithConventionalPrecedence() ParseOption {
+	return func(o *parseOptions) {
+		o.conventionalPrecedence = true
+	}
+}
+
+// WithMixedAndOrWarnings calls fn for every place where `and` and `or` are mixed without parentheses,
+// e.g. `a or b and c`, as the meaning of such a rule depends on the precedence in use. Each `and` or `or`
+// that follows the other operator is reported, so `a and b or c and d` has two warnings.
+func WithMixedAndOrWarnings(fn func(Warning)) ParseOption {
+	return func(o *parseOptions) {
+		o.warn = fn
+	}
+}
+
+// Warning is a diagnostic about a rule that parsed successfully.
+type Warning struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("line %d:%d: %s", w.Line, w.Column, w.Message)
+}
+
 type KV = map[string]any
 
 type Ctx struct {
 	KV        KV
 	Macros    map[string]Rule
 	Functions map[string]*Function
+
+	// variables bound by quantifiers, which shadow KV keys
+	scope *scope
 }
 
 func (c *Ctx) Eval(r Rule) Result {
 	return r.Eval(c)
 }
 
+// reservedFuncs are the stdlib functions that custom functions and macros may not override, and whose arguments
+// are checked when the rule is parsed. Functions added to the stdlib since may be overridden, with any number of
+// arguments, so that existing custom functions and macros with those names keep working.
+var reservedFuncs = map[string]bool{
+	"starts_with": true,
+}
+
 func (c *Ctx) Validate() error {
 	for name, fn := range c.Functions {
-		if _, ok := StdlibFuncs[name]; ok {
+		if reservedFuncs[name] {
 			return fmt.Errorf("function %q: name conflicts with a stdlib function", name)
 		}
 		if fn == nil {
@@ -148,7 +286,7 @@ func (c *Ctx) Validate() error {
 		}
 	}
 	for name, macro := range c.Macros {
-		if _, ok := StdlibFuncs[name]; ok {
+		if reservedFuncs[name] {
 			return fmt.Errorf("macro %q: name conflicts with a stdlib function", name)
 		}
 		if _, ok := c.Functions[name]; ok {
@@ -201,8 +339,9 @@ func (r *rule) String() string {
 		return "<empty>"
 	}
 	s := r.Rule.String()
-	if len(s) > 0 && s[0] == '(' {
-		return strings.TrimSuffix(s[1:], ")")
+	switch r.Rule.(type) {
+	case *nodeAnd, *nodeOr:
+		return strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
 	}
 	return s
 }
@@ -260,6 +399,9 @@ func (e *ParseError) Error() string {
 			"op_NOT", `"!"`,
 			"op_AND", `"&&"`,
 			"op_OR", `"||"`,
+			"op_CONV_AND", `"&&"`,
+			"op_CONV_OR", `"||"`,
+			"op_PIPE", `"|"`,
 			"op_EQ", `"=="`,
 			"op_NE", `"!="`,
 			"op_GT", `">"`,
@@ -268,12 +410,38 @@ func (e *ParseError) Error() string {
 			"op_LE", `"<="`,
 			"op_CONTAINS", `"contains"`,
 			"op_MATCHES", `"=~"`,
+			"op_IEQ", `"ieq"`,
+			"op_INE", `"ine"`,
+			"op_ICONTAINS", `"icontains"`,
+			"op_GLOB", `"glob"`,
+			"op_HOSTGLOB", `"hostglob"`,
+			"op_EXISTS", `"exists"`,
+			"op_ADD", `"+"`,
+			"op_SUB", `"-"`,
+			"op_MUL", `"*"`,
+			"op_DIV", `"/"`,
+			"op_MOD", `"%"`,
+			"op_QUESTION", `"?"`,
+			"op_COLON", `":"`,
+			"op_IF", `"if"`,
+			"op_THEN", `"then"`,
+			"op_ELSE", `"else"`,
+			"op_COALESCE", `"??"`,
+			"op_RANGE", `".."`,
 			"token_INT", `"integer"`,
 			"token_FLOAT", `"float"`,
+			"token_BYTES", `"byte size"`,
 			"token_BOOL", `"boolean"`,
+			"token_NULL", `"null"`,
 			"token_IP_CIDR", `"cidr"`,
 			"token_IP", `"ip"`,
 			"token_REGEX", `"regex"`,
+			"token_DURATION", `"duration"`,
+			"token_TIMESTAMP", `"timestamp"`,
+			"token_SEMVER", `"semver"`,
+			"token_MAC_PREFIX", `"MAC prefix"`,
+			"token_MAC", `"MAC address"`,
+			"token_FIELD_PATH", `"field path"`,
 			"token_FIELD", `"field name"`,
 			"token_STRING", `"string"`,
 			"token_HEX_STRING", `"hex"`,
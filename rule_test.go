@@ -2,10 +2,13 @@ package rulekit
 
 import (
 	"errors"
+	"math"
 	"net"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -492,7 +495,7 @@ func TestParseError(t *testing.T) {
 		"field == %1==",
 		"== true",
 		"test == >=",
-		"field == 123 && ip == 1.2.3",
+		"field == 123 && ip == 1.2.3.4.5",
 		"field == 123 && ip << 1",
 		"str == 'bad qu\\\"ote'",
 	} {
@@ -558,8 +561,22 @@ func FuzzParse(f *testing.F) {
 }
 
 func TestArray(t *testing.T) {
-	assertParseError(t, `field == [1,]`)           // trailing commas are not allowed
-	assertParseError(t, `field == [1, [1, 2], 3]`) // nested arrays are not allowed
+	assertParseError(t, `field == [1,]`) // trailing commas are not allowed
+	assertParseError(t, `field == []`)
+
+	// elements may be any expression, including nested arrays
+	assertRulep(t, `[1, [2, "str"]]`, nil).
+		Ok().
+		Value([]any{int64(1), []any{int64(2), "str"}}).
+		EvaluatedRule(`[1, [2, "str"]]`)
+	assertRulep(t, `[a, b + 1, a > 1, starts_with(s, "x"), [a]]`, kv{"a": 2, "b": 3, "s": "xyz"}).
+		Ok().
+		Value([]any{2, int64(4), true, true, []any{2}})
+	assertParseEval(t, `field in [1, [2, 3]]`, kv{"field": 3}, true)
+	assertParseEval(t, `field == [a, b]`, kv{"field": 3, "a": 1, "b": 3}, true)
+	assertRulep(t, `field == [a, b]`, kv{"field": 3, "a": 1}).MissingFields("b")
+	require.Equal(t, `[a > 1, (b and c), [1, 2]]`, MustParse(`[a > 1, b and c, [1, 2]]`).String())
+	assertParseError(t, `field > [1, [2, "str"]]`)
 
 	assertRulep(t, `[1, "str", 3]`, nil).
 		Ok().
@@ -897,6 +914,15 @@ func TestCustomFunction(t *testing.T) {
 		Functions: fns,
 	}).ErrorString(`function "custom_func" expects 1 arguments, got 2`)
 
+	// arguments may be any expression
+	assertRulep(t, `custom_func(a > 1 ? "big" : "small")`, &ctx{
+		KV:        KV{"a": 2},
+		Functions: fns,
+	}).Ok().Value(`Got msg: big`)
+	assertRulep(t, `starts_with(a == 1, true)`, &ctx{KV: KV{"a": 1}}).Pass()
+	assertRulep(t, `starts_with([a, b], "[1")`, &ctx{KV: KV{"a": 1, "b": 2}}).Pass()
+	assertRulep(t, `starts_with(a + b, 3)`, &ctx{KV: KV{"a": 1}}).MissingFields("b")
+
 	// mix & match functions, macros, stdlib functions
 	assertRulep(t, `starts_with(macro(), "Got msg")`, &ctx{
 		Functions: fns,
@@ -906,6 +932,52 @@ func TestCustomFunction(t *testing.T) {
 	}).Pass()
 }
 
+func TestFunctionPredicates(t *testing.T) {
+	fns := map[string]*Function{
+		"count_if": {
+			Args: []FunctionArg{
+				{Name: "items"},
+				{Name: "pred", Var: "x"},
+			},
+			Eval: func(args map[string]any) Result {
+				items, err := IndexFuncArg[[]any](args, "items")
+				if err != nil {
+					return Result{Error: err}
+				}
+				pred, err := IndexFuncArg[Predicate](args, "pred")
+				if err != nil {
+					return Result{Error: err}
+				}
+				var n int64
+				for _, item := range items {
+					res := pred(item)
+					if !res.Ok() {
+						return res
+					}
+					if res.Pass() {
+						n++
+					}
+				}
+				return Result{Value: n}
+			},
+		},
+	}
+	items := []any{1, 5, 7, 10}
+
+	// the predicate is evaluated by the function with x bound to each item
+	assertRulep(t, `count_if(items, x > 5) == 2`, &ctx{KV: KV{"items": items}, Functions: fns}).Pass()
+	assertRulep(t, `count_if(items, x > limit)`, &ctx{KV: KV{"items": items, "limit": 6}, Functions: fns}).Ok().Value(int64(2))
+	assertRulep(t, `items | count_if(x % 5 == 0)`, &ctx{KV: KV{"items": items}, Functions: fns}).Ok().Value(int64(2))
+	assertRulep(t, `count_if(items, x.n > 1)`, &ctx{KV: KV{"items": []any{KV{"n": 2}, KV{"n": 1}}}, Functions: fns}).Ok().Value(int64(1))
+	// x shadows a KV key of the same name, and is only bound inside the predicate
+	assertRulep(t, `count_if(items, x > 5) == x`, &ctx{KV: KV{"items": items, "x": 2}, Functions: fns}).Pass()
+	// and nests with quantifiers
+	assertRulep(t, `any(y in [items], count_if(y, x > 5) == 2)`, &ctx{KV: KV{"items": items}, Functions: fns}).Pass()
+
+	assertRulep(t, `count_if(items, x > limit)`, &ctx{KV: KV{"items": items}, Functions: fns}).MissingFields("limit")
+	assertRulep(t, `count_if(items, y > 5)`, &ctx{KV: KV{"items": items}, Functions: fns}).MissingFields("y")
+}
+
 func TestCtx_Validate(t *testing.T) {
 	tcs := []struct {
 		name string
@@ -971,6 +1043,17 @@ func TestCtx_Validate(t *testing.T) {
 			},
 			err: `function "starts_with": name conflicts with a stdlib function`,
 		},
+		{
+			name: "newer stdlib functions can be overridden",
+			ctx: &Ctx{
+				Macros: map[string]Rule{
+					"now": MustParse(`true`),
+				},
+				Functions: map[string]*Function{
+					"lower": {},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -985,6 +1068,59 @@ func TestCtx_Validate(t *testing.T) {
 	}
 }
 
+func TestStdlibOverride(t *testing.T) {
+	input := &ctx{
+		KV: KV{"a": 1, "items": []any{1, 2}},
+		Macros: map[string]Rule{
+			"now": MustParse(`a == 1`),
+		},
+		Functions: map[string]*Function{
+			"len": {
+				Args: []FunctionArg{{Name: "v"}},
+				Eval: func(args map[string]any) Result {
+					return Result{Value: "custom"}
+				},
+			},
+		},
+	}
+	assertRulep(t, `now()`, input).Pass()
+	assertRulep(t, `len(items) == "custom"`, input).Pass()
+	assertRulep(t, `a == 1`, input).Pass()
+
+	// the stdlib is used when nothing overrides it
+	assertRulep(t, `len(items) == 2`, kv{"items": []any{1, 2}}).Pass()
+
+	// overrides may take a different number of arguments than the stdlib function
+	input = &ctx{
+		KV: KV{"s": "a,b"},
+		Macros: map[string]Rule{
+			"semver": MustParse(`true`),
+		},
+		Functions: map[string]*Function{
+			"split": {
+				Args: []FunctionArg{{Name: "s"}},
+				Eval: func(args map[string]any) Result {
+					s, err := IndexFuncArg[string](args, "s")
+					if err != nil {
+						return Result{Error: err}
+					}
+					return Result{Value: strings.Split(s, ",")}
+				},
+			},
+		},
+	}
+	assertRulep(t, `split(s) == ["a", "b"]`, input).Pass()
+	assertRulep(t, `s | split == ["a", "b"]`, input).Pass()
+	assertRulep(t, `semver()`, input).Pass()
+	assertRulep(t, `split(s, ",")`, input).ErrorString(`function "split" expects 1 arguments, got 2`)
+
+	// without an override, the stdlib arity is checked when the rule is evaluated
+	assertRulep(t, `split(s)`, kv{"s": "a,b"}).ErrorString(`function "split" expects 2 arguments, got 1`)
+
+	// reserved functions are still checked when the rule is parsed
+	assertParseError(t, `starts_with(s)`)
+}
+
 func TestEval_invalid_ctx(t *testing.T) {
 	assertRulep(t, `true`, &ctx{
 		Functions: map[string]*Function{
@@ -1024,3 +1160,932 @@ func TestFieldNames(t *testing.T) {
 		require.Error(t, err, r)
 	}
 }
+
+func TestArithmetic(t *testing.T) {
+	assertParseEval(t, `bytes_out / duration > 1000`, kv{"bytes_out": 5000, "duration": 2}, true)
+	assertParseEval(t, `bytes_out / duration > 1000`, kv{"bytes_out": 1500, "duration": 2}, false)
+	assertParseEval(t, `status - 400 < 100`, kv{"status": 404}, true)
+	assertParseEval(t, `status - 400 < 100`, kv{"status": 503}, false)
+	assertParseEval(t, `1 + 2 * 3 == 7`, nil, true)
+	assertParseEval(t, `10 - 4 - 3 == 3`, nil, true)
+	assertParseEval(t, `7 % 4 == 3`, nil, true)
+	assertParseEval(t, `a * 0.5 == 1.5`, kv{"a": 3}, true)
+	assertParseEval(t, `a - -1 == 2`, kv{"a": uint64(1)}, true)
+	assertParseEval(t, `a -1 == 0`, kv{"a": 1}, true)
+	assertParseEval(t, `9223372036854775807 + 1 == 9223372036854775808`, nil, true)
+
+	// field names may contain dashes, so operators must be surrounded by whitespace
+	assertParseEval(t, `a-1 == 5`, kv{"a-1": 5}, true)
+
+	// a slash following an operand is a division rather than a regex
+	assertParseEval(t, `a / b > c / d`, kv{"a": 10, "b": 2, "c": 9, "d": 3}, true)
+	assertParseEval(t, `a / 2 == 5 and path =~ /^\/api\//`, kv{"a": 10, "path": "/api/v1"}, true)
+
+	assertRulep(t, `a + b > 1`, kv{"a": "str", "b": 1}).
+		NotOk().
+		ErrorString("invalid operation: operator + not defined on string and int")
+	assertRulep(t, `a / b > 1`, kv{"a": 1, "b": 0}).
+		NotOk().
+		ErrorString("invalid operation: division by zero")
+	assertRulep(t, `a + b > 1`, kv{}).
+		MissingFields("a").
+		EvaluatedRule(`a + b > 1`)
+	assertRulep(t, `a * 2`, kv{"a": 2}).
+		Ok().
+		Value(int64(4))
+
+	require.Equal(t, `a + b * c - d / e % f > 1`, MustParse(`a + b * c - d / e % f > 1`).String())
+	require.Equal(t, `a + -1 > 1`, MustParse(`a + - 1 > 1`).String())
+
+	assertParseError(t, `a + > 1`)
+	assertParseError(t, `a + "str" > 1`)
+	assertParseError(t, `-a > 1`)
+
+	// unary minus only applies to numeric and duration literals
+	assertParseError(t, `-a == -5`)
+	assertParseError(t, `-(a) == -5`)
+	assertParseError(t, `-(a + 1) == -5`)
+	assertParseEval(t, `0 - a == -5`, kv{"a": 5}, true)
+	assertParseEval(t, `a * -1 == -5`, kv{"a": 5}, true)
+	assertParseEval(t, `d == -5m`, kv{"d": -5 * time.Minute}, true)
+}
+
+func TestExpressionOperands(t *testing.T) {
+	fns := map[string]*Function{
+		"score": {
+			Args: []FunctionArg{{Name: "x"}},
+			Eval: func(args map[string]any) Result {
+				return Result{Value: args["x"]}
+			},
+		},
+	}
+
+	// function calls on either side of inequality operators
+	assertParseEval(t, `score(x) >= 0.8`, &ctx{Functions: fns, KV: KV{"x": 0.9}}, true)
+	assertParseEval(t, `score(x) >= 0.8`, &ctx{Functions: fns, KV: KV{"x": 0.5}}, false)
+	assertParseEval(t, `5 < score(x)`, &ctx{Functions: fns, KV: KV{"x": 6}}, true)
+	assertParseEval(t, `score(a) + score(b) > 10`, &ctx{Functions: fns, KV: KV{"a": 6, "b": 6}}, true)
+
+	// parenthesized sub-expressions
+	assertParseEval(t, `(a + b) * 2 == 10`, kv{"a": 2, "b": 3}, true)
+	assertParseEval(t, `a - (b - c) == 4`, kv{"a": 5, "b": 3, "c": 2}, true)
+	assertParseEval(t, `(a > 1) == true`, kv{"a": 2}, true)
+	assertParseEval(t, `((a)) > (b)`, kv{"a": 2, "b": 1}, true)
+
+	// arrays compare each element
+	assertParseEval(t, `f > [1, 10]`, kv{"f": 5}, true)
+	assertParseEval(t, `f > [6, 10]`, kv{"f": 5}, false)
+
+	// in accepts any value-producing expression on the right side
+	assertParseEval(t, `f in allowed`, kv{"f": "b", "allowed": []string{"a", "b"}}, true)
+	assertParseEval(t, `f in allowed`, kv{"f": "c", "allowed": []any{"a", "b"}}, false)
+	assertParseEval(t, `ip in net`, kv{"ip": net.ParseIP("10.1.2.3"), "net": parseCIDR(t, "10.0.0.0/8")}, true)
+	assertParseEval(t, `v in r`, kv{"v": 5, "r": Range{Low: int64(1), High: int64(10)}}, true)
+
+	// in is not a substring test when the right side is a string or another non-collection value
+	assertParseEval(t, `"a" in name`, kv{"name": "abc"}, false)
+	assertParseEval(t, `f in name`, kv{"f": "abc", "name": "abc"}, false)
+	assertParseEval(t, `f in n`, kv{"f": 1, "n": 1}, false)
+	assertParseEval(t, `!(f in name)`, kv{"f": "a", "name": "abc"}, true)
+
+	for rule, want := range map[string]string{
+		`(a + b) * c > 1`:   `(a + b) * c > 1`,
+		`a - (b - c) == 1`:  `a - (b - c) == 1`,
+		`(a > 1) == true`:   `(a > 1) == true`,
+		`(a * b) + c > 1`:   `a * b + c > 1`,
+		`score(x) >= 0.8`:   `score(x) >= 0.8`,
+		`not (a + 1 > 2)`:   `not (a + 1 > 2)`,
+		`(a or b) and c`:    `(a or b) and c`,
+		`x in (a or b)`:     `x in (a or b)`,
+		`((a + b)) * c > 1`: `(a + b) * c > 1`,
+	} {
+		require.Equal(t, want, MustParse(rule).String(), rule)
+	}
+
+	// literals that can never satisfy the operator
+	assertParseErrorValue(t, `f >= "string"`, "syntax error at line 1:14:\nf >= \"string\"\n             ^\ninvalid operation: operator >= not defined on string value \"string\"")
+	assertParseError(t, `f > [1, "a"]`)
+	assertParseError(t, `f in "abc"`)
+	assertParseError(t, `[1, 2] + 1 > 0`)
+	assertParseError(t, `a > b > c`)
+	assertParseError(t, `a == b == c`)
+}
+
+func TestConditional(t *testing.T) {
+	{
+		f := MustParse(`if tls then 443 else 80 == port`)
+		require.Equal(t, `(if tls then 443 else 80) == port`, f.String())
+
+		assertEval(t, f, kv{"tls": true, "port": 443}, true)
+		assertEval(t, f, kv{"tls": true, "port": 80}, false)
+		assertEval(t, f, kv{"tls": false, "port": 80}, true)
+	}
+
+	{
+		f := MustParse(`(method == "GET" ? read_limit : write_limit) > bytes`)
+		require.Equal(t, `(method == "GET" ? read_limit : write_limit) > bytes`, f.String())
+
+		assertEval(t, f, kv{"method": "GET", "read_limit": 100, "bytes": 50}, true)
+		assertEval(t, f, kv{"method": "POST", "write_limit": 10, "bytes": 50}, false)
+	}
+
+	// only the chosen branch is evaluated
+	assertRulep(t, `tls ? 443 : missing`, kv{"tls": true}).Ok().Value(int64(443))
+	assertRulep(t, `tls ? missing : 80`, kv{"tls": true}).MissingFields("missing")
+	assertRulep(t, `cond ? 1 : 2`, kv{}).MissingFields("cond").EvaluatedRule(`cond ? 1 : 2`)
+
+	// the evaluated rule is the chosen branch
+	assertRulep(t, `a ? b == 1 : c == 2`, kv{"a": 0, "c": 2}).Pass().EvaluatedRule(`c == 2`)
+
+	// the condition uses Result.Pass() semantics
+	assertRulep(t, `s ? 1 : 2`, kv{"s": ""}).Value(int64(2))
+	assertRulep(t, `s ? 1 : 2`, kv{"s": "x"}).Value(int64(1))
+
+	// ternaries are right-associative and bind looser than boolean operators
+	assertRulep(t, `a ? 1 : b ? 2 : 3`, kv{"a": false, "b": true}).Value(int64(2))
+	assertRulep(t, `a and b ? 1 : 2`, kv{"a": true, "b": false}).Value(int64(2))
+	assertRulep(t, `if a then 1 else if b then 2 else 3`, kv{"a": false, "b": false}).Value(int64(3))
+	assertParseEval(t, `if a then 1 else 2 + 1 == 3`, kv{"a": false}, true)
+
+	for rule, want := range map[string]string{
+		`a ? b : c`:                  `a ? b : c`,
+		`(a ? b : c) ? d : e`:        `(a ? b : c) ? d : e`,
+		`(a ? b : c) and d`:          `(a ? b : c) and d`,
+		`if a then b else (c == d)`:  `if a then b else (c == d)`,
+		`a ? b : c ? d : e`:          `a ? b : c ? d : e`,
+		`if x then y else z + 1 > 2`: `(if x then y else z + 1) > 2`,
+	} {
+		require.Equal(t, want, MustParse(rule).String(), rule)
+	}
+
+	assertParseError(t, `if a then b`)
+	assertParseError(t, `a ? b`)
+	assertParseError(t, `(tls ? "a" : "b") > 1`)
+}
+
+func TestIndex(t *testing.T) {
+	input := kv{
+		"headers": map[string]string{"Content-Type": "application/json"},
+		"labels":  KV{"k8s.io/app": "api", "team name": "core"},
+		"args":    []any{"--verbose", int64(3)},
+		"ports":   []int{80, 443},
+		"matrix":  []any{[]string{"a", "b"}},
+		"counts":  map[string]int{"GET": 2},
+		"key":     "Content-Type",
+		"i":       1,
+	}
+
+	assertParseEval(t, `headers["Content-Type"] == "application/json"`, input, true)
+	assertParseEval(t, `headers[key] contains "json"`, input, true)
+	assertParseEval(t, `labels["k8s.io/app"] == "api"`, input, true)
+	assertParseEval(t, `labels['team name'] == "core"`, input, true)
+	assertParseEval(t, `args[0] == "--verbose"`, input, true)
+	assertParseEval(t, `args[i] > 2`, input, true)
+	assertParseEval(t, `ports[i - 1] == 80`, input, true)
+	assertParseEval(t, `matrix[0][1] == "b"`, input, true)
+	assertParseEval(t, `counts["GET"] == 2`, input, true)
+	assertParseEval(t, `[10, 20, 30][2] == 30`, nil, true)
+	assertParseEval(t, `ports[0] + ports[1] == 523`, input, true)
+
+	assertRulep(t, `headers["Accept"] == "*/*"`, input).
+		MissingFields(`headers["Accept"]`).
+		EvaluatedRule(`headers["Accept"] == "*/*"`)
+	assertRulep(t, `missing[0] == 1`, input).
+		MissingFields("missing")
+	assertRulep(t, `ports[2] == 1`, input).
+		Error(&ErrIndexOutOfRange{Index: int64(2), Len: 2})
+	assertRulep(t, `ports[-1] == 1`, input).
+		ErrorString("index out of range [-1] with length 2")
+	// indexes that don't fit in an int are reported as written
+	assertRulep(t, `args[9999999999999999999] == 1`, input).
+		ErrorString("index out of range [9999999999999999999] with length 2")
+	assertRulep(t, `args[i] == 1`, kv{"args": []any{1}, "i": uint64(math.MaxUint64)}).
+		Error(&ErrIndexOutOfRange{Index: uint64(math.MaxUint64), Len: 1})
+	assertRulep(t, `ports["a"] == 1`, input).
+		ErrorString("invalid operation: cannot index array with string")
+	assertRulep(t, `i[0] == 1`, input).
+		ErrorString("invalid operation: cannot index int")
+
+	for rule, want := range map[string]string{
+		`headers["Content-Type"]`: `headers["Content-Type"]`,
+		`args[i + 1]`:             `args[i + 1]`,
+		`matrix[0][1]`:            `matrix[0][1]`,
+		`(a ? b : c)[0]`:          `(a ? b : c)[0]`,
+		`a + b[0] * 2`:            `a + b[0] * 2`,
+	} {
+		require.Equal(t, want, MustParse(rule).String(), rule)
+	}
+
+	assertParseError(t, `args[1.5]`)
+	assertParseError(t, `args[true]`)
+	assertParseError(t, `args[]`)
+}
+
+func TestQuantifiers(t *testing.T) {
+	ips := []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("10.1.2.3")}
+	mixed := []any{net.ParseIP("10.0.0.1"), net.ParseIP("8.8.8.8")}
+
+	assertParseEval(t, `all(x in ips, x in 10.0.0.0/8)`, kv{"ips": ips}, true)
+	assertParseEval(t, `all(x in ips, x in 10.0.0.0/8)`, kv{"ips": mixed}, false)
+	assertParseEval(t, `any(x in ips, x in 10.0.0.0/8)`, kv{"ips": mixed}, true)
+	assertParseEval(t, `none(x in ips, x in 192.168.0.0/16)`, kv{"ips": mixed}, true)
+	assertParseEval(t, `none(x in ips, x == 8.8.8.8)`, kv{"ips": mixed}, false)
+
+	// map values are iterated
+	headers := map[string]string{"User-Agent": "curl", "X-Payload": "evil"}
+	assertParseEval(t, `any(h in headers, h matches /evil/)`, kv{"headers": headers}, true)
+	assertParseEval(t, `none(h in headers, h matches /evil/)`, kv{"headers": headers}, false)
+
+	// empty collections
+	assertParseEval(t, `all(x in xs, x > 1)`, kv{"xs": []int{}}, true)
+	assertParseEval(t, `any(x in xs, x > 1)`, kv{"xs": []int{}}, false)
+	assertParseEval(t, `none(x in xs, x > 1)`, kv{"xs": []int{}}, true)
+
+	// array literals and fields of bound maps
+	assertParseEval(t, `ANY (x in [1, 2, 3], x * 2 == 6)`, nil, true)
+	assertParseEval(t, `all(r in requests, r.status < 500)`, kv{"requests": []any{KV{"status": 200}, KV{"status": 404}}}, true)
+	// bound maps of any type resolve dotted paths like KV
+	labels := []map[string]string{{"k": "v", "a.b": "dotted"}, {"k": "v"}}
+	assertParseEval(t, `all(x in labels, x.k == "v")`, kv{"labels": labels}, true)
+	assertParseEval(t, `all(x in labels, x.k == x["k"])`, kv{"labels": labels}, true)
+	assertParseEval(t, `any(x in labels, x.a.b == "dotted")`, kv{"labels": labels}, true)
+	assertParseEval(t, `any(x in xs, x.inner.n == 2)`, kv{"xs": []any{map[string]map[string]int{"inner": {"n": 2}}}}, true)
+	assertRulep(t, `all(x in labels, x.missing == "v")`, kv{"labels": labels}).
+		MissingFields("x.missing")
+
+	// the loop variable shadows KV keys and is scoped to the predicate
+	assertParseEval(t, `all(x in xs, x > 1) and x == "kv"`, kv{"xs": []int{2, 3}, "x": "kv"}, true)
+	assertRulep(t, `all(x in xs, x.missing == 1)`, kv{"xs": []any{KV{}}, "x": KV{"missing": 1}}).
+		MissingFields("x.missing")
+
+	// nested quantifiers and outer variables
+	assertParseEval(t, `any(a in as, all(b in bs, a > b))`, kv{"as": []int{1, 5}, "bs": []int{2, 3}}, true)
+	assertParseEval(t, `any(a in as, all(b in bs, a > b))`, kv{"as": []int{1, 2}, "bs": []int{2, 3}}, false)
+	assertParseEval(t, `any(x in xs, any(x in x, x == 2))`, kv{"xs": []any{[]int{1}, []int{2}}}, true)
+
+	// fields named after quantifiers remain valid
+	assertParseEval(t, `any == 1 and all == 2`, kv{"any": 1, "all": 2}, true)
+	// but functions named after quantifiers can't be called
+	assertParseError(t, `any(x) == 1`)
+
+	// an element that cannot be evaluated makes the result undetermined,
+	// unless another element already determines it
+	assertRulep(t, `all(x in xs, x > limit)`, kv{"xs": []int{1}}).
+		MissingFields("limit").
+		EvaluatedRule(`all(x in xs, x > limit)`)
+	assertRulep(t, `any(x in xs, x.a == 1)`, kv{"xs": []any{KV{}, KV{"a": 1}}}).
+		Ok().
+		Pass()
+	assertRulep(t, `any(x in xs, x > 1)`, kv{}).
+		MissingFields("xs")
+	assertRulep(t, `any(x in xs, x > 1)`, kv{"xs": 1}).
+		ErrorString("invalid operation: cannot iterate over int")
+
+	require.Equal(t, `all(x in ips, x == 10.0.0.0/8)`, MustParse(`all(x in ips, x in 10.0.0.0/8)`).String())
+
+	assertParseError(t, `all(ips, x > 1)`)
+	assertParseError(t, `all(x.y in ips, x > 1)`)
+	assertParseError(t, `all(x in ips)`)
+}
+
+func TestTime(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// durations
+	assertParseEval(t, `age > 5m`, kv{"age": 6 * time.Minute}, true)
+	assertParseEval(t, `age > 5m`, kv{"age": 5 * time.Minute}, false)
+	assertParseEval(t, `age == 1h30m`, kv{"age": 90 * time.Minute}, true)
+	assertParseEval(t, `age < 1.5s`, kv{"age": time.Second}, true)
+	assertParseEval(t, `age >= 250ms and age < 500µs + 1s`, kv{"age": 300 * time.Millisecond}, true)
+	assertParseEval(t, `offset == -5m`, kv{"offset": -5 * time.Minute}, true)
+	// durations compare against numeric seconds
+	assertParseEval(t, `age > 5m`, kv{"age": 301}, true)
+	assertParseEval(t, `age > 60`, kv{"age": time.Minute}, false)
+	assertParseEval(t, `timeout == "30s"`, kv{"timeout": 30 * time.Second}, true)
+
+	// timestamps
+	assertParseEval(t, `expires_at < 2026-01-01T00:00:00Z`, kv{"expires_at": ts.Add(-time.Second)}, true)
+	assertParseEval(t, `expires_at < 2026-01-01T00:00:00Z`, kv{"expires_at": ts}, false)
+	assertParseEval(t, `expires_at == 2026-01-01T02:00:00.000+02:00`, kv{"expires_at": ts}, true)
+	// timestamps compare against unix seconds and RFC 3339 strings
+	assertParseEval(t, `expires_at < 2026-01-01T00:00:00Z`, kv{"expires_at": ts.Unix() - 1}, true)
+	assertParseEval(t, `expires_at == "2026-01-01T00:00:00Z"`, kv{"expires_at": ts}, true)
+
+	// arithmetic
+	assertParseEval(t, `created_at + 24h > 2026-01-01T12:00:00Z`, kv{"created_at": ts}, true)
+	assertParseEval(t, `end - start == 90s`, kv{"start": ts, "end": ts.Add(90 * time.Second)}, true)
+	assertParseEval(t, `d * 2 == 1m`, kv{"d": 30 * time.Second}, true)
+	assertParseEval(t, `d / 2 == 15s`, kv{"d": 30 * time.Second}, true)
+	assertParseEval(t, `1h / d == 2`, kv{"d": 30 * time.Minute}, true)
+	assertRulep(t, `d + 1 > 0`, kv{"d": time.Second}).
+		ErrorString("invalid operation: operator + not defined on time.Duration and int64")
+	assertRulep(t, `t * 2 > 0`, kv{"t": ts}).
+		ErrorString("invalid operation: operator * not defined on time.Time and int64")
+
+	// the zero time and duration are falsy
+	assertRulep(t, `t`, kv{"t": time.Time{}}).Fail()
+	assertRulep(t, `d`, kv{"d": time.Duration(0)}).Fail()
+
+	require.Equal(t, `created_at > now() - 24h`, MustParse(`created_at > now() - 24h`).String())
+	require.Equal(t, `age < 1h30m`, MustParse(`age < 1h30m`).String())
+
+	assertParseError(t, `age > 5x`)
+	assertParseError(t, `t > 2026-13-01T00:00:00Z`)
+}
+
+func TestNullAndExists(t *testing.T) {
+	// == null covers both absent and nil fields
+	assertRulep(t, `user.id == null`, kv{}).Pass()
+	assertRulep(t, `user.id == null`, kv{"user": map[string]any{"id": nil}}).Pass()
+	assertRulep(t, `user.id == null`, kv{"user": map[string]any{"id": ""}}).Fail()
+	assertRulep(t, `null == user.id`, kv{}).Pass()
+	assertRulep(t, `user.id != null`, kv{}).Fail()
+	assertRulep(t, `user.id != null`, kv{"user": map[string]any{"id": "123"}}).Pass()
+	assertRulep(t, `null == null`, nil).Pass()
+	assertRulep(t, `args[1] == null`, kv{"args": []any{"a"}}).Pass()
+	// null elements of arrays are matched by in, contains and ==
+	assertRulep(t, `n in [null, 1]`, kv{"n": nil}).Pass()
+	assertRulep(t, `!(n in [null, 1])`, kv{"n": nil}).Fail()
+	assertRulep(t, `n == [null, 1]`, kv{"n": nil}).Pass()
+	assertRulep(t, `n in [1, 2]`, kv{"n": nil}).Fail()
+	assertRulep(t, `arr contains null`, kv{"arr": []any{1, nil}}).Pass()
+	assertRulep(t, `arr contains null`, kv{"arr": []any{1}}).Fail()
+	assertRulep(t, `arr == null`, kv{"arr": []any{1}}).Fail()
+	assertRulep(t, `arr != null`, kv{"arr": []any{1}}).Pass()
+	// other comparisons still report missing fields
+	assertRulep(t, `user.id == ""`, kv{}).NotOk().MissingFields("user.id")
+	assertRulep(t, `f == ""`, kv{"f": nil}).Fail()
+	assertRulep(t, `f != ""`, kv{"f": nil}).Pass()
+
+	// exists never returns a missing fields error
+	assertRulep(t, `exists(user.id)`, kv{}).Ok().Fail()
+	assertRulep(t, `exists user.id`, kv{"user": map[string]any{"id": nil}}).Pass()
+	assertRulep(t, `exists headers["X-Forwarded-For"]`, kv{"headers": map[string]any{}}).Fail()
+	assertRulep(t, `exists args[2]`, kv{"args": []any{1}}).Fail()
+	assertRulep(t, `exists user.id and user.id == "123"`, kv{"user": map[string]any{"id": "123"}}).Pass()
+
+	r := MustParse(`!exists(user.id) or user.id != ""`)
+	assertRule(t, r, kv{}).Pass()
+	assertRule(t, r, kv{"user": map[string]any{"id": "123"}}).Pass()
+	assertRule(t, r, kv{"user": map[string]any{"id": ""}}).Fail()
+
+	// not negates its operand
+	assertRulep(t, `!exists(a)`, kv{"a": 1}).Fail()
+	assertRulep(t, `not (a > 1)`, kv{"a": 2}).Fail()
+
+	// other errors are still returned
+	assertRulep(t, `exists(a + 1)`, kv{"a": "str"}).NotOk()
+
+	require.Equal(t, `!exists(user.id) or user.id != ""`, r.String())
+	require.Equal(t, `user.id == null`, MustParse(`user.id == null`).String())
+
+	assertParseError(t, `f > null`)
+	assertParseError(t, `f + null`)
+	assertParseError(t, `exists`)
+}
+
+func TestCoalesce(t *testing.T) {
+	r := MustParse(`region ?? "us-east-1" == "eu-west-1"`)
+	assertRule(t, r, kv{}).Fail()
+	assertRule(t, r, kv{"region": "eu-west-1"}).Pass()
+	assertRule(t, r, kv{"region": nil}).Fail()
+
+	r = MustParse(`retries ?? 0 < 3`)
+	assertRule(t, r, kv{}).Pass()
+	assertRule(t, r, kv{"retries": 5}).Fail()
+
+	// a missing optional field no longer makes the whole rule undetermined
+	assertRulep(t, `method == "GET" and (tier ?? "free") == "free"`, kv{"method": "GET"}).Pass()
+
+	// chained defaults and expressions
+	assertRulep(t, `a ?? b ?? 10`, kv{"b": 5}).Value(5)
+	assertRulep(t, `a ?? b ?? 10`, kv{}).Value(int64(10))
+	assertRulep(t, `headers["X-Retry"] ?? 0`, kv{"headers": map[string]any{}}).Value(int64(0))
+	assertRulep(t, `args[3] ?? "none"`, kv{"args": []any{}}).Value("none")
+	assertRulep(t, `limit ?? default_limit`, kv{"default_limit": 100}).Value(100)
+	assertRulep(t, `(a ?? 1) + 1`, kv{}).Value(int64(2))
+
+	// the default may itself be missing
+	assertRulep(t, `a ?? b`, kv{}).NotOk().MissingFields("b")
+	// other errors are not caught
+	assertRulep(t, `(a + 1) ?? 0`, kv{"a": "str"}).NotOk()
+
+	for in, out := range map[string]string{
+		`region ?? "us-east-1" == "eu-west-1"`: `region ?? "us-east-1" == "eu-west-1"`,
+		`a ?? b ?? c`:                          `a ?? b ?? c`,
+		`(a ?? b) ?? c`:                        `(a ?? b) ?? c`,
+		`(a ?? 1) + 1`:                         `(a ?? 1) + 1`,
+		`a ?? 1 + 1`:                           `a ?? 1 + 1`,
+	} {
+		require.Equal(t, out, MustParse(in).String())
+	}
+
+	assertParseError(t, `a ?? "str" > 1`)
+	assertParseError(t, `a ??`)
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	assertParseEval(t, `method ieq "get"`, kv{"method": "GET"}, true)
+	assertParseEval(t, `method ieq "get"`, kv{"method": "POST"}, false)
+	assertParseEval(t, `method ine "get"`, kv{"method": "Get"}, false)
+	assertParseEval(t, `method ine "get"`, kv{"method": "POST"}, true)
+	assertParseEval(t, `host icontains "EXAMPLE"`, kv{"host": "api.Example.com"}, true)
+	assertParseEval(t, `host icontains "qpoint"`, kv{"host": "api.example.com"}, false)
+	// unicode case folding
+	assertParseEval(t, `name ieq "straße"`, kv{"name": "STRAßE"}, true)
+	assertParseEval(t, `name icontains "σ"`, kv{"name": "ΟΔΥΣΣΕΥΣ"}, true)
+	assertParseEval(t, `name ieq "k"`, kv{"name": "K"}, true) // Kelvin sign
+
+	// arrays on either side
+	assertParseEval(t, `method ieq ["get", "head"]`, kv{"method": "HEAD"}, true)
+	assertParseEval(t, `method ine ["get", "head"]`, kv{"method": "HEAD"}, false)
+	assertParseEval(t, `method ine ["get", "head"]`, kv{"method": "POST"}, true)
+	assertParseEval(t, `headers icontains "content-type"`, kv{"headers": []string{"Content-Type", "Accept"}}, true)
+	assertParseEval(t, `headers icontains "type"`, kv{"headers": []string{"Content-Type", "Accept"}}, false)
+	assertParseEval(t, `headers ieq "accept"`, kv{"headers": []string{"Content-Type", "Accept"}}, true)
+	assertParseEval(t, `headers icontains "accept"`, kv{"headers": []any{"Content-Type", "Accept"}}, true)
+
+	// non-string values compare as usual
+	assertParseEval(t, `code ieq 200`, kv{"code": 200}, true)
+	assertParseEval(t, `ip ieq "2001:DB8::1"`, kv{"ip": net.ParseIP("2001:db8::1")}, true)
+	assertParseEval(t, `path ieq /^\/API/`, kv{"path": "/api"}, false)
+
+	// operators are case-sensitive by default
+	assertParseEval(t, `method == "get"`, kv{"method": "GET"}, false)
+	assertParseEval(t, `host contains "EXAMPLE"`, kv{"host": "api.example.com"}, false)
+
+	require.Equal(t, `method ieq "get" and host icontains "example"`, MustParse(`method IEQ "get" && host ICONTAINS "example"`).String())
+}
+
+func TestRegexFlags(t *testing.T) {
+	assertParseEval(t, `method matches /^get$/i`, kv{"method": "GET"}, true)
+	assertParseEval(t, `method matches /^get$/`, kv{"method": "GET"}, false)
+	assertParseEval(t, `path =~ |^/API/|i`, kv{"path": "/api/v1"}, true)
+	// s: . matches \n
+	assertParseEval(t, `body =~ /a.b/s`, kv{"body": "a\nb"}, true)
+	assertParseEval(t, `body =~ /a.b/`, kv{"body": "a\nb"}, false)
+	// m: ^ and $ match at line boundaries
+	assertParseEval(t, `body =~ /^b$/m`, kv{"body": "a\nb\nc"}, true)
+	assertParseEval(t, `body =~ /^b$/`, kv{"body": "a\nb\nc"}, false)
+	assertParseEval(t, `body =~ /^B.C$/ims`, kv{"body": "a\nb\nc"}, true)
+	assertParseEval(t, `body =~ /^B.C$/smi`, kv{"body": "a\nb\nc"}, true)
+	// flags apply to regexes in arrays
+	assertParseEval(t, `method == [/^get$/i, /^head$/i]`, kv{"method": "Head"}, true)
+
+	// flags round-trip
+	for _, s := range []string{
+		`method =~ /^get$/i`,
+		`body =~ /^B.C$/smi`,
+		`path =~ |^/API/|i`,
+		`method == [/^get$/i, /^head$/]`,
+	} {
+		require.Equal(t, s, MustParse(s).String())
+	}
+
+	// a regex followed by an operator is not affected
+	assertParseEval(t, `a =~ /x/ and b =~ /y/i`, kv{"a": "x", "b": "Y"}, true)
+
+	assertParseError(t, `method =~ /get/x`)
+	assertParseError(t, `method =~ /get/I`)
+}
+
+func TestGlobOperators(t *testing.T) {
+	assertParseEval(t, `path glob "/api/*/users/**"`, kv{"path": "/api/v1/users/42"}, true)
+	assertParseEval(t, `path glob "/api/*/users/**"`, kv{"path": "/api/v1/org/users"}, false)
+	assertParseEval(t, `host hostglob "*.example.com"`, kv{"host": "API.example.com"}, true)
+	assertParseEval(t, `host hostglob "*.example.com"`, kv{"host": "a.b.example.com"}, false)
+	assertParseEval(t, `host glob "*.example.com"`, kv{"host": "a.b.example.com"}, true)
+
+	// string slices match if any element matches
+	assertParseEval(t, `sni hostglob "*.internal"`, kv{"sni": []string{"example.com", "db.internal"}}, true)
+	assertParseEval(t, `sni hostglob "*.internal"`, kv{"sni": []string{"example.com"}}, false)
+	assertParseEval(t, `paths glob "/admin/**"`, kv{"paths": []any{"/", "/admin/users"}}, true)
+
+	// non-string values do not match
+	assertParseEval(t, `port glob "8*"`, kv{"port": 8080}, false)
+
+	// patterns are not parsed as IP addresses like other quoted strings
+	assertParseEval(t, `host hostglob "10.0.0.1"`, kv{"host": "10.0.0.1"}, true)
+
+	require.Equal(t, `not (host hostglob "*.example.com") and path glob "/api/**"`,
+		MustParse(`!(host HOSTGLOB "*.example.com") && path glob "/api/**"`).String())
+
+	assertParseError(t, `path glob /api/`)
+	assertParseError(t, `path glob field`)
+}
+
+func TestRange(t *testing.T) {
+	r := MustParse(`port in 1024..65535`)
+	assertRule(t, r, kv{"port": 1024}).Pass()
+	assertRule(t, r, kv{"port": 65535}).Pass()
+	assertRule(t, r, kv{"port": uint64(8080)}).Pass()
+	assertRule(t, r, kv{"port": 80}).Fail()
+	assertRule(t, r, kv{"port": 65536}).Fail()
+	assertRule(t, r, kv{"port": "8080"}).Fail()
+
+	assertParseEval(t, `ratio in 0.5..1.5`, kv{"ratio": 1}, true)
+	assertParseEval(t, `ratio in 0.5..1.5`, kv{"ratio": 0.25}, false)
+	assertParseEval(t, `code in 500 .. 599`, kv{"code": 503}, true)
+	assertParseEval(t, `offset in -10..10`, kv{"offset": -5}, true)
+	// empty range
+	assertParseEval(t, `port in 10..1`, kv{"port": 5}, false)
+
+	// IP ranges
+	r = MustParse(`ip in 10.0.0.5..10.0.0.50`)
+	assertRule(t, r, kv{"ip": net.ParseIP("10.0.0.5")}).Pass()
+	assertRule(t, r, kv{"ip": net.ParseIP("10.0.0.42")}).Pass()
+	assertRule(t, r, kv{"ip": net.ParseIP("10.0.0.51")}).Fail()
+	assertRule(t, r, kv{"ip": net.ParseIP("10.0.1.6")}).Fail()
+	assertRule(t, r, kv{"ip": "10.0.0.6"}).Pass()
+	assertRule(t, r, kv{"ip": net.ParseIP("2001:db8::1")}).Fail()
+	assertParseEval(t, `ip in 2001:db8::1..2001:db8::ff`, kv{"ip": net.ParseIP("2001:db8::a")}, true)
+
+	// durations and timestamps
+	assertParseEval(t, `latency in 100ms..2s`, kv{"latency": time.Second}, true)
+	assertParseEval(t, `latency in 100ms..2s`, kv{"latency": 3 * time.Second}, false)
+	assertParseEval(t, `t in 2026-01-01T00:00:00Z..2026-02-01T00:00:00Z`, kv{"t": time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)}, true)
+
+	// bounds may be any expression
+	r = MustParse(`port in min_port .. max_port + 1`)
+	assertRule(t, r, kv{"port": 11, "min_port": 1, "max_port": 10}).Pass()
+	assertRule(t, r, kv{"port": 12, "min_port": 1, "max_port": 10}).Fail()
+	assertRule(t, r, kv{"port": 12}).NotOk().MissingFields("min_port")
+
+	for in, out := range map[string]string{
+		`port in 1024..65535`:              `port in 1024..65535`,
+		`port in 1024 .. 65535`:            `port in 1024..65535`,
+		`ip in 10.0.0.5..10.0.0.50`:        `ip in 10.0.0.5..10.0.0.50`,
+		`port in min_port .. max_port + 1`: `port in min_port .. max_port + 1`,
+	} {
+		require.Equal(t, out, MustParse(in).String())
+	}
+
+	assertParseError(t, `port in "a".."z"`)
+	assertParseError(t, `port in 1..2..3`)
+	assertParseError(t, `port in 1..`)
+}
+
+func TestSemver(t *testing.T) {
+	r := MustParse(`agent_version >= 1.12.0`)
+	assertRule(t, r, kv{"agent_version": "1.12.0"}).Pass()
+	assertRule(t, r, kv{"agent_version": "v1.13.2"}).Pass()
+	assertRule(t, r, kv{"agent_version": "1.9.0"}).Fail()
+	assertRule(t, r, kv{"agent_version": "1.12.0-rc.1"}).Fail()
+	// invalid versions are not comparable
+	assertRule(t, r, kv{"agent_version": "latest"}).Fail()
+	assertRule(t, r, kv{"agent_version": 2}).Fail()
+
+	r = MustParse(`version < 2.0.0-rc1`)
+	assertRule(t, r, kv{"version": "2.0.0-beta"}).Pass()
+	assertRule(t, r, kv{"version": "2.0.0-rc1"}).Fail()
+	assertRule(t, r, kv{"version": "2.0.0"}).Fail()
+
+	assertParseEval(t, `version == 1.0.0`, kv{"version": "1.0.0+build.7"}, true)
+	assertParseEval(t, `version != 1.0.0`, kv{"version": "1.0.1"}, true)
+	assertParseEval(t, `1.12.0 <= version`, kv{"version": "1.12.0"}, true)
+	assertParseEval(t, `version in [1.0.0, 1.1.0]`, kv{"version": "1.1.0"}, true)
+	assertParseEval(t, `version in 1.0.0..1.9.9`, kv{"version": "1.5.0"}, true)
+	assertParseEval(t, `version in 1.0.0..1.9.9`, kv{"version": "2.0.0"}, false)
+
+	// semver() converts values that can't be written as a literal
+	assertParseEval(t, `semver(version) > semver("v1.2.3")`, kv{"version": "v1.10.0"}, true)
+	assertRulep(t, `semver(version) > 1.0.0`, kv{"version": "latest"}).ErrorString(`invalid semantic version "latest"`)
+
+	require.Equal(t, `version < 2.0.0-rc.1+build.5`, MustParse(`version < 2.0.0-rc.1+build.5`).String())
+
+	assertParseError(t, `version > 01.2.3`)
+	assertParseError(t, `version + 1.2.3 > 1`)
+}
+
+func TestNumericLiterals(t *testing.T) {
+	for in, want := range map[string]any{
+		`0x1F`:        int64(31),
+		`0XfF`:        int64(255),
+		`0o755`:       int64(493),
+		`0b1010`:      int64(10),
+		`1_000_000`:   int64(1000000),
+		`0xFFFF_FFFF`: int64(0xFFFFFFFF),
+		`1e6`:         float64(1e6),
+		`2.5e-3`:      float64(0.0025),
+		`1_000.5`:     float64(1000.5),
+		`-0x10`:       int64(-16),
+		`10KB`:        int64(10_000),
+		`5MiB`:        int64(5 << 20),
+		`1GB`:         int64(1_000_000_000),
+		`1.5KiB`:      int64(1536),
+		`512B`:        int64(512),
+		`16EiB`:       nil,
+	} {
+		if want == nil {
+			assertParseError(t, in)
+			continue
+		}
+		assertRulep(t, in, nil).Value(want)
+		// literals round-trip
+		require.Equal(t, in, MustParse(in).String())
+	}
+
+	assertParseEval(t, `size > 10KB and size <= 5MiB`, kv{"size": 20_000}, true)
+	assertParseEval(t, `size > 10KB`, kv{"size": 10_000}, false)
+	assertParseEval(t, `count >= 1e6`, kv{"count": 1_000_000}, true)
+	assertParseEval(t, `mode == 0o755`, kv{"mode": 493}, true)
+	assertParseEval(t, `limit == 2 * 1MiB`, kv{"limit": 2 << 20}, true)
+
+	// not a whole number of bytes
+	assertParseError(t, `size > 1.0001KB`)
+	assertParseError(t, `size > 0x`)
+	assertParseError(t, `size > 1__000`)
+}
+
+func TestMAC(t *testing.T) {
+	mac := mustParseMac("aa:bb:cc:dd:ee:ff")
+
+	// colon, dash and Cisco dotted notation
+	for _, lit := range []string{"aa:bb:cc:dd:ee:ff", "AA:BB:CC:DD:EE:FF", "aa-bb-cc-dd-ee-ff", "aabb.ccdd.eeff"} {
+		r := MustParse(`mac == ` + lit)
+		assertRule(t, r, kv{"mac": mac}).Pass()
+		assertRule(t, r, kv{"mac": mustParseMac("aa:bb:cc:dd:ee:00")}).Fail()
+		// string fields are parsed as MAC addresses
+		assertRule(t, r, kv{"mac": "AA-BB-CC-DD-EE-FF"}).Pass()
+		assertRule(t, r, kv{"mac": "not a mac"}).Fail()
+		require.Equal(t, `mac == `+lit, r.String())
+
+		lv := MustParse(lit).Eval(&Ctx{})
+		require.IsType(t, net.HardwareAddr{}, lv.Value)
+	}
+	assertParseEval(t, `mac != aa:bb:cc:dd:ee:ff`, kv{"mac": mac}, false)
+	assertParseEval(t, `mac in [00:00:00:00:00:01, aa:bb:cc:dd:ee:ff]`, kv{"mac": mac}, true)
+
+	// OUI prefixes
+	for _, rule := range []string{`mac == aa:bb:cc:*`, `mac in aa:bb:cc/24`, `mac in aa-bb-cc-*`, `mac in [00:11:22:*, aa:bb:cc:*]`} {
+		r := MustParse(rule)
+		assertRule(t, r, kv{"mac": mac}).Pass()
+		assertRule(t, r, kv{"mac": "aa:bb:cc:00:00:01"}).Pass()
+		assertRule(t, r, kv{"mac": mustParseMac("aa:bb:cd:dd:ee:ff")}).Fail()
+	}
+	assertParseEval(t, `mac != aa:bb:cc:*`, kv{"mac": mac}, false)
+	require.Equal(t, `mac == aa:bb:cc/24`, MustParse(`mac in aa:bb:cc/24`).String())
+
+	// hex strings and arithmetic are unaffected
+	assertParseEval(t, `f == 47:45:54`, kv{"f": "GET"}, true)
+	assertParseEval(t, `10/2 == 5`, nil, true)
+
+	assertParseError(t, `mac > aa:bb:cc:dd:ee:ff`)
+	assertParseError(t, `mac in aa:bb:cc/30`)
+	assertParseError(t, `mac in aa:bb:cc/23`)
+	assertParseError(t, `mac in aa:bb:cc/0`)
+	assertParseError(t, `mac in aa:bb:cc:dd/7`)
+	assertParseError(t, `mac in 00:11:22:33:44:55`)
+}
+
+func TestQuotedFields(t *testing.T) {
+	input := kv{
+		"2xx_count":  3,
+		"k8s.io/app": "top",
+		"labels": map[string]any{
+			"k8s.io/app": "web",
+			"k8s":        map[string]any{"io/app": "nested"},
+		},
+		"http": map[string]any{
+			"request": map[string]any{
+				"header": map[string]any{"x-forwarded-for:port": "8080"},
+			},
+		},
+		"and": true,
+	}
+
+	assertParseEval(t, "`2xx_count` == 3", input, true)
+	assertParseEval(t, "`k8s.io/app` == \"top\"", input, true)
+	assertParseEval(t, "http.request.header.`x-forwarded-for:port` == \"8080\"", input, true)
+	assertParseEval(t, "`and`", input, true)
+
+	// a dot inside a quoted segment is part of the key
+	assertParseEval(t, "labels.`k8s.io/app` == \"web\"", input, true)
+	assertParseEval(t, "labels.`k8s`.`io/app` == \"nested\"", input, true)
+	assertRulep(t, "labels.`k8s`.io == \"web\"", input).NotOk().MissingFields("labels.k8s.io")
+	assertRulep(t, "`labels.k8s.io/app` == \"web\"", input).NotOk().MissingFields("`labels.k8s.io/app`")
+	assertRulep(t, "labels.`k8s.io/app` == \"web\"", kv{"labels": map[string]any{"k8s": map[string]any{"io/app": "web"}}}).
+		NotOk().
+		MissingFields("labels.`k8s.io/app`")
+
+	// escapes
+	assertParseEval(t, "`a\\`b` == 1", kv{"a`b": 1}, true)
+
+	// quantifier variables
+	assertParseEval(t, "any(l in list, l.`k8s.io/app` == \"web\")", kv{"list": []any{map[string]any{"k8s.io/app": "web"}}}, true)
+
+	for _, rule := range []string{
+		"`2xx_count` == 3",
+		"labels.`k8s.io/app` == \"web\"",
+		"http.request.header.`x-forwarded-for:port` == \"8080\"",
+		"`a\\`b` == 1",
+		"!`and`",
+	} {
+		require.Equal(t, rule, MustParse(rule).String())
+	}
+
+	assertParseError(t, "`unterminated == 1")
+	assertParseError(t, "labels.`a`b == 1")
+}
+
+func TestWildcardPaths(t *testing.T) {
+	input := kv{
+		"requests": []any{
+			map[string]any{
+				"status":  200,
+				"headers": []map[string]any{{"name": "Accept"}, {"name": "Host"}},
+			},
+			map[string]any{
+				"status":  503,
+				"headers": []map[string]any{{"name": "X-Forwarded-For"}},
+			},
+			map[string]any{"headers": []any{}},
+		},
+		"services": map[string]any{
+			"api": map[string]any{"port": 8080},
+			"web": map[string]any{"port": 443},
+		},
+		"labels": []map[string]string{{"k8s.io/app": "api"}},
+	}
+
+	assertParseEval(t, `requests[*].status == 503`, input, true)
+	assertParseEval(t, `requests.*.status == 404`, input, false)
+	assertParseEval(t, `requests[*].status != 200`, input, false)
+	assertParseEval(t, `requests[*].headers[*].name == "X-Forwarded-For"`, input, true)
+	assertParseEval(t, `requests.*.headers.*.name contains "Host"`, input, true)
+	assertParseEval(t, `services.*.port == 443`, input, true)
+	assertParseEval(t, "labels[*].`k8s.io/app` == \"api\"", input, true)
+	assertParseEval(t, `all(r in requests[*].status, r < 600)`, input, true)
+	assertParseEval(t, `any(r in requests, r.headers[*].name == "Host")`, input, true)
+
+	// the results are flattened and elements missing the rest of the path are skipped
+	assertRule(t, MustParse(`requests[*].status`), input).Value([]any{200, 503})
+	assertRule(t, MustParse(`requests[*].headers[*].name`), input).Value([]any{"Accept", "Host", "X-Forwarded-For"})
+	assertRule(t, MustParse(`services[*].port`), input).Value([]any{8080, 443})
+	assertRule(t, MustParse(`requests[*].missing`), input).Value([]any{})
+
+	assertRulep(t, `missing[*].name == "a"`, input).MissingFields("missing[*].name")
+	assertRulep(t, `requests[*].status.*`, input).Value([]any{})
+
+	// multiplication is unaffected
+	assertParseEval(t, `a*b == 6`, kv{"a": 2, "b": 3}, true)
+	assertParseEval(t, `a * b == 6`, kv{"a": 2, "b": 3}, true)
+
+	for rule, want := range map[string]string{
+		`requests[*].status`:        `requests[*].status`,
+		`requests.*.status`:         `requests[*].status`,
+		`a.b[*][*].c`:               `a.b[*][*].c`,
+		"items.*.`k8s.io/app` == 1": "items[*].`k8s.io/app` == 1",
+	} {
+		require.Equal(t, want, MustParse(rule).String(), rule)
+	}
+}
+
+func TestPipe(t *testing.T) {
+	// custom functions take precedence over the stdlib lower and trim
+	stringFn := func(fn func(string) string) *Function {
+		return &Function{
+			Args: []FunctionArg{{Name: "s"}},
+			Eval: func(args map[string]any) Result {
+				s, err := IndexFuncArg[string](args, "s")
+				if err != nil {
+					return Result{Error: err}
+				}
+				return Result{Value: fn(s)}
+			},
+		}
+	}
+	fns := map[string]*Function{
+		"lower": stringFn(strings.ToLower),
+		"trim":  stringFn(strings.TrimSpace),
+	}
+	input := &ctx{KV: KV{"url": "  HTTPS://Example.com  ", "scheme": "HTTP"}, Functions: fns}
+
+	for rule, want := range map[string]bool{
+		`url | lower | trim == "https://example.com"`:   true,
+		`url | trim | starts_with("HTTPS://")`:          true,
+		`url | lower | starts_with("https://") == true`: false,
+		`missing ?? scheme | lower == "http"`:           true,
+		`scheme | lower == "http" || false`:             true,
+	} {
+		assertRulep(t, rule, input).Ok().Value(want)
+	}
+
+	// pipes desugar into nested function calls
+	for rule, want := range map[string]string{
+		`url | lower | trim`:                   `trim(lower(url))`,
+		`url | starts_with("https://")`:        `starts_with(url, "https://")`,
+		`a + b | f == 1`:                       `f(a + b) == 1`,
+		`starts_with(url | lower, "https://")`: `starts_with(lower(url), "https://")`,
+	} {
+		require.Equal(t, want, MustParse(rule).String(), rule)
+	}
+
+	// pipe-delimited regexes are only parsed where an operand is expected
+	assertRulep(t, `url | trim =~ |^HTTPS://|`, input).Pass()
+	assertRulep(t, `scheme =~ |http|i || false`, input).Pass()
+
+	assertParseError(t, `url | starts_with`)
+	assertParseError(t, `url | "lower"`)
+	assertParseError(t, `url | lower(`)
+}
+
+func TestAndOrPrecedence(t *testing.T) {
+	// by default or binds tighter than and
+	require.Equal(t, `(a or b) and c`, MustParse(`a or b and c`).String())
+	require.Equal(t, `a and (b or c)`, MustParse(`a and b or c`).String())
+	assertParseEval(t, `true or false and false`, nil, false)
+
+	conventional := WithConventionalPrecedence()
+	for rule, want := range map[string]string{
+		`a or b and c`:          `a or (b and c)`,
+		`a && b || c`:           `(a and b) or c`,
+		`a or b and c or d`:     `(a or (b and c)) or d`,
+		`(a or b) and c`:        `(a or b) and c`,
+		`!a and b or c and !d`:  `(!a and b) or (c and !d)`,
+		`a == 1 or b in [1, 2]`: `a == 1 or b in [1, 2]`,
+	} {
+		require.Equal(t, want, MustParse(rule, conventional).String(), rule)
+	}
+	assertRule(t, MustParse(`true or false and false`, conventional), nil).Pass()
+	assertRule(t, MustParse(`a or b and c`, conventional), kv{"a": true, "b": false}).Pass()
+
+	_, err := Parse(`a or and b`, conventional)
+	require.ErrorContains(t, err, `unexpected "&&"`)
+}
+
+func TestMixedAndOrWarnings(t *testing.T) {
+	for rule, want := range map[string][]Warning{
+		`a and b`:        nil,
+		`a and (b or c)`: nil,
+		`a or b and c`:   {{Line: 1, Column: 8, Message: "and/or mixed without parentheses"}},
+		"a && b ||\n c && d": {
+			{Line: 1, Column: 8, Message: "and/or mixed without parentheses"},
+			{Line: 2, Column: 4, Message: "and/or mixed without parentheses"},
+		},
+		`(a or b and c) and d`:            {{Line: 1, Column: 9, Message: "and/or mixed without parentheses"}},
+		`all(x in a or b, c and d)`:       nil,
+		`any(x in xs, x or y) and z or w`: {{Line: 1, Column: 28, Message: "and/or mixed without parentheses"}},
+		`a or b ? c and d : e`:            nil,
+		`x[a or b and c] == y`:            {{Line: 1, Column: 10, Message: "and/or mixed without parentheses"}},
+		`a or b and (c and d or e)`: {
+			{Line: 1, Column: 8, Message: "and/or mixed without parentheses"},
+			{Line: 1, Column: 21, Message: "and/or mixed without parentheses"},
+		},
+		// every switch between and and or is reported
+		`a and b or c and d or e`: {
+			{Line: 1, Column: 9, Message: "and/or mixed without parentheses"},
+			{Line: 1, Column: 14, Message: "and/or mixed without parentheses"},
+			{Line: 1, Column: 20, Message: "and/or mixed without parentheses"},
+		},
+		`a and b and c or d or e and f`: {
+			{Line: 1, Column: 15, Message: "and/or mixed without parentheses"},
+			{Line: 1, Column: 25, Message: "and/or mixed without parentheses"},
+		},
+	} {
+		var got []Warning
+		_, err := Parse(rule, WithMixedAndOrWarnings(func(w Warning) {
+			got = append(got, w)
+		}))
+		require.NoError(t, err, rule)
+		require.Equal(t, want, got, rule)
+	}
+
+	require.Equal(t, "line 2:3: and/or mixed without parentheses", Warning{Line: 2, Column: 3, Message: "and/or mixed without parentheses"}.String())
+}
+
+func TestReservedWords(t *testing.T) {
+	// keywords can't be used as bare field names, but may be quoted with backticks
+	for _, word := range []string{"if", "then", "else", "exists", "glob", "hostglob", "ieq", "ine", "icontains", "IF", "Exists"} {
+		assertParseError(t, word+` == 1`)
+		assertParseEval(t, "`"+word+"` == 1", kv{word: 1}, true)
+		// longer names containing a keyword are still fields
+		assertParseEval(t, word+`_id == 1 and a.`+word+` == 2`, kv{word + "_id": 1, "a": KV{word: 2}}, true)
+	}
+	// null is a literal rather than a field
+	assertParseEval(t, `null == 1`, kv{"null": 1}, false)
+	assertParseEval(t, "`null` == 1", kv{"null": 1}, true)
+
+	// 1B is a byte size rather than a hex string; hex strings may be written in lower case
+	assertParseEval(t, `size == 1B`, kv{"size": 1}, true)
+	assertParseEval(t, `magic == 1b`, kv{"magic": "\x1b"}, true)
+
+	// six dash-separated or three dot-separated groups of hex digits are MAC addresses rather than fields
+	assertParseEval(t, `aa-bb-cc-dd-ee-ff == src.mac`, kv{"src": KV{"mac": net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}}}, true)
+	assertParseEval(t, "`aa-bb-cc-dd-ee-ff` == 1 and `dead.beef.cafe` == 2", kv{"aa-bb-cc-dd-ee-ff": 1, "dead.beef.cafe": 2}, true)
+}
+
+func TestNot(t *testing.T) {
+	// not negates the truthiness of its operand
+	assertParseEval(t, `not a`, kv{"a": true}, false)
+	assertParseEval(t, `not a`, kv{"a": false}, true)
+	assertParseEval(t, `!a`, kv{"a": 1}, false)
+	assertParseEval(t, `!a`, kv{"a": ""}, true)
+	assertParseEval(t, `!a`, kv{"a": nil}, true)
+	assertParseEval(t, `not (a > 1)`, kv{"a": 2}, false)
+	assertParseEval(t, `not (a > 1)`, kv{"a": 1}, true)
+	assertParseEval(t, `!(tags contains "admin")`, kv{"tags": []string{"admin"}}, false)
+	assertParseEval(t, `!(tags contains "admin")`, kv{"tags": []string{"user"}}, true)
+	assertParseEval(t, `not not a`, kv{"a": true}, true)
+	assertRulep(t, `not a`, kv{}).MissingFields("a")
+}
@@ -0,0 +1,140 @@
+package rulekit
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a semantic version (https://semver.org) retaining the original input string
+type Semver struct {
+	raw_value  string
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      string
+}
+
+func (v Semver) String() string {
+	return v.raw_value
+}
+
+// ParseSemver parses a semantic version such as 1.12.0, 2.0.0-rc.1 or 1.0.0+build.5.
+// A leading "v" is allowed, e.g. v1.2.3.
+func ParseSemver(s string) (Semver, error) {
+	v := Semver{raw_value: s}
+	str := strings.TrimPrefix(s, "v")
+
+	str, build, hasBuild := strings.Cut(str, "+")
+	str, pre, hasPre := strings.Cut(str, "-")
+
+	parts := strings.Split(str, ".")
+	if len(parts) != 3 {
+		return Semver{}, fmt.Errorf("invalid semantic version %q", s)
+	}
+	for i, dst := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		n, err := parseSemverNumber(parts[i])
+		if err != nil {
+			return Semver{}, fmt.Errorf("invalid semantic version %q", s)
+		}
+		*dst = n
+	}
+
+	if hasPre {
+		v.Prerelease = strings.Split(pre, ".")
+		for _, id := range v.Prerelease {
+			if !isSemverIdent(id) || (isNumeric(id) && len(id) > 1 && id[0] == '0') {
+				return Semver{}, fmt.Errorf("invalid semantic version %q", s)
+			}
+		}
+	}
+	if hasBuild {
+		for _, id := range strings.Split(build, ".") {
+			if !isSemverIdent(id) {
+				return Semver{}, fmt.Errorf("invalid semantic version %q", s)
+			}
+		}
+		v.Build = build
+	}
+	return v, nil
+}
+
+func parseSemverNumber(s string) (uint64, error) {
+	if s == "" || !isNumeric(s) || (len(s) > 1 && s[0] == '0') {
+		return 0, fmt.Errorf("invalid version number %q", s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func isSemverIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumeric(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Compare returns -1, 0 or 1 if v has a lower, equal or higher precedence than other.
+// Build metadata does not affect precedence.
+func (v Semver) Compare(other Semver) int {
+	if c := cmp.Compare(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+
+	// a version without a prerelease has a higher precedence than one with a prerelease
+	switch {
+	case len(v.Prerelease) == 0 && len(other.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(other.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < min(len(v.Prerelease), len(other.Prerelease)); i++ {
+		if c := comparePrereleaseIdent(v.Prerelease[i], other.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	// a larger set of prerelease fields has a higher precedence
+	return cmp.Compare(len(v.Prerelease), len(other.Prerelease))
+}
+
+// comparePrereleaseIdent compares numeric identifiers numerically and alphanumeric identifiers in ASCII order.
+// Numeric identifiers have a lower precedence than alphanumeric identifiers.
+func comparePrereleaseIdent(a, b string) int {
+	aNum, bNum := isNumeric(a), isNumeric(b)
+	switch {
+	case aNum && bNum:
+		if c := cmp.Compare(len(a), len(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
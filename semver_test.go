@@ -0,0 +1,68 @@
+package rulekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemverCompare(t *testing.T) {
+	// ordered by increasing precedence, per https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.2.0",
+		"1.12.0",
+		"2.0.0-rc1",
+		"2.0.0",
+		"10.0.0",
+	}
+	for i, x := range ordered {
+		for j, y := range ordered {
+			xv, err := ParseSemver(x)
+			require.NoError(t, err)
+			yv, err := ParseSemver(y)
+			require.NoError(t, err)
+
+			want := cmpResultEqual
+			if i < j {
+				want = cmpResultLess
+			} else if i > j {
+				want = cmpResultGreater
+			}
+			require.Equalf(t, want, xv.Compare(yv), "%s ? %s", x, y)
+		}
+	}
+
+	// build metadata does not affect precedence
+	a, _ := ParseSemver("1.0.0+build.1")
+	b, _ := ParseSemver("1.0.0+build.2")
+	require.Equal(t, cmpResultEqual, a.Compare(b))
+	require.Equal(t, "build.1", a.Build)
+}
+
+func TestParseSemver(t *testing.T) {
+	v, err := ParseSemver("v1.2.3-rc.1+sha.5114f85")
+	require.NoError(t, err)
+	require.Equal(t, Semver{
+		raw_value:  "v1.2.3-rc.1+sha.5114f85",
+		Major:      1,
+		Minor:      2,
+		Patch:      3,
+		Prerelease: []string{"rc", "1"},
+		Build:      "sha.5114f85",
+	}, v)
+	require.Equal(t, "v1.2.3-rc.1+sha.5114f85", v.String())
+
+	for _, s := range []string{"", "1", "1.2", "1.2.3.4", "01.2.3", "1.2.x", "1.2.3-", "1.2.3-rc..1", "1.2.3-01", "1.2.3+", "1.2.3+a_b"} {
+		_, err := ParseSemver(s)
+		require.Errorf(t, err, "%q", s)
+	}
+}
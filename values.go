@@ -1,8 +1,13 @@
 package rulekit
 
 import (
+	"fmt"
+	"maps"
 	"net"
+	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/qpoint-io/rulekit/set"
 )
@@ -10,7 +15,10 @@ import (
 type FieldValue string
 
 func (f FieldValue) Eval(ctx *Ctx) Result {
-	val, ok := IndexKV(ctx.KV, string(f))
+	val, ok, bound := ctx.scope.lookup(string(f))
+	if !bound {
+		val, ok = IndexKV(ctx.KV, string(f))
+	}
 	if !ok {
 		return Result{
 			Error:         &ErrMissingFields{Fields: set.NewSet(string(f))},
@@ -27,6 +35,137 @@ func (f FieldValue) String() string {
 	return string(f)
 }
 
+// FieldPath is a field referenced by its path segments, e.g. labels.`k8s.io/app` or items[*].name.
+// Unlike FieldValue, a dot inside a segment is part of the key rather than a path separator.
+type FieldPath []PathSegment
+
+// PathSegment is a key in a FieldPath, or a wildcard matching every element of a slice or value of a map.
+type PathSegment struct {
+	Key      string
+	Wildcard bool
+}
+
+func (f FieldPath) Eval(ctx *Ctx) Result {
+	val, ok, bound := ctx.scope.lookupPath(f)
+	if !bound {
+		val, ok = IndexKVPath(ctx.KV, f)
+	}
+	if !ok {
+		return Result{
+			Error:         &ErrMissingFields{Fields: set.NewSet(f.String())},
+			EvaluatedRule: f,
+		}
+	}
+	return Result{
+		Value:         val,
+		EvaluatedRule: f,
+	}
+}
+
+// String returns the path with segments that can't be written as a plain field name quoted in backticks
+// and wildcards written as [*].
+func (f FieldPath) String() string {
+	var b strings.Builder
+	for i, s := range f {
+		if s.Wildcard {
+			b.WriteString("[*]")
+			continue
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		// a single segment is always quoted so that it isn't parsed as a keyword, e.g. `and`
+		if len(f) == 1 || !isPlainFieldSegment(s.Key, i == 0) {
+			b.WriteString("`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(s.Key) + "`")
+		} else {
+			b.WriteString(s.Key)
+		}
+	}
+	return b.String()
+}
+
+func (f FieldPath) hasWildcard() bool {
+	for _, s := range f {
+		if s.Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// isPlainFieldSegment reports whether s may be written without quotes in a field path.
+func isPlainFieldSegment(s string, first bool) bool {
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		case c >= '0' && c <= '9', c == '-':
+			if first && i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// scope is a linked list of variables bound by quantifiers, innermost first.
+type scope struct {
+	name   string
+	value  any
+	parent *scope
+}
+
+// lookup resolves a field path whose first segment is a bound variable, e.g. `r.status` where r is bound to a map
+// of any type.
+// bound is false if the path does not refer to a variable, in which case the KV map should be used instead.
+func (s *scope) lookup(path string) (val any, ok bool, bound bool) {
+	name, rest, nested := strings.Cut(path, ".")
+	for ; s != nil; s = s.parent {
+		if s.name != name {
+			continue
+		}
+		if !nested {
+			return s.value, true, true
+		}
+		val, ok = indexDotted(s.value, rest)
+		return val, ok, true
+	}
+	return nil, false, false
+}
+
+// indexDotted is like IndexKV for maps of any type, e.g. a map[string]string bound by a quantifier.
+func indexDotted(container any, path string) (any, bool) {
+	if m, ok := container.(map[string]any); ok {
+		return IndexKV(m, path)
+	}
+	// a direct key match takes precedence over a nested path, as in IndexKV
+	if val, ok, err := indexValue(container, path); err == nil && ok {
+		return val, true
+	}
+	key, rest, nested := strings.Cut(path, ".")
+	if !nested {
+		return nil, false
+	}
+	val, ok, err := indexValue(container, key)
+	if err != nil || !ok {
+		return nil, false
+	}
+	return indexDotted(val, rest)
+}
+
+// lookupPath is like lookup for a path of segments.
+func (s *scope) lookupPath(path FieldPath) (val any, ok bool, bound bool) {
+	for ; s != nil; s = s.parent {
+		if s.name != path[0].Key {
+			continue
+		}
+		val, ok = indexPath(s.value, path[1:])
+		return val, ok, true
+	}
+	return nil, false, false
+}
+
 type LiteralValue[T any] struct {
 	raw   string
 	value T
@@ -116,6 +255,10 @@ func isZero(val any) bool {
 		return v == nil || v.IP == nil
 	case []any:
 		return len(v) == 0
+	case time.Time:
+		return v.IsZero()
+	case time.Duration:
+		return v == 0
 	}
 	return false
 }
@@ -167,3 +310,207 @@ func IndexKV(m KV, key string) (any, bool) {
 		start = idx + 1
 	}
 }
+
+// IndexKVPath returns the value at the given path in nested maps and slices.
+// Unlike IndexKV, each segment is matched as a whole key, so keys may contain dots.
+//
+// A wildcard segment fans out over every element of a slice or value of a map (ordered by key),
+// resolving the rest of the path against each of them. The results are returned as a flattened
+// []any, skipping elements for which the rest of the path is missing.
+func IndexKVPath(m KV, path FieldPath) (any, bool) {
+	if m == nil || len(path) == 0 {
+		return nil, false
+	}
+	return indexPath(m, path)
+}
+
+func indexPath(val any, path FieldPath) (any, bool) {
+	for i, seg := range path {
+		if seg.Wildcard {
+			elems, ok := wildcardValues(val)
+			if !ok {
+				return nil, false
+			}
+			rest := path[i+1:]
+			nested := rest.hasWildcard()
+			ret := []any{}
+			for _, el := range elems {
+				v, ok := indexPath(el, rest)
+				if !ok {
+					continue
+				}
+				if nested {
+					// flatten the results of nested wildcards
+					ret = append(ret, v.([]any)...)
+				} else {
+					ret = append(ret, v)
+				}
+			}
+			return ret, true
+		}
+
+		v, ok, err := indexValue(val, seg.Key)
+		if err != nil || !ok {
+			return nil, false
+		}
+		val = v
+	}
+	return val, true
+}
+
+// wildcardValues returns the elements of a slice or the values of a map ordered by key.
+func wildcardValues(container any) ([]any, bool) {
+	switch c := container.(type) {
+	case []any:
+		return c, true
+	case map[string]any:
+		ret := make([]any, 0, len(c))
+		for _, k := range slices.Sorted(maps.Keys(c)) {
+			ret = append(ret, c[k])
+		}
+		return ret, true
+	}
+
+	v := reflect.ValueOf(container)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		ret := make([]any, v.Len())
+		for i := range ret {
+			ret[i] = v.Index(i).Interface()
+		}
+		return ret, true
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+		ret := make([]any, len(keys))
+		for i, k := range keys {
+			ret[i] = v.MapIndex(k).Interface()
+		}
+		return ret, true
+	}
+	return nil, false
+}
+
+// indexValue returns the element of a map or slice at the given key or index. ok is false if a map does not
+// contain the key; an index outside of a slice returns ErrIndexOutOfRange.
+func indexValue(container any, key any) (val any, ok bool, err error) {
+	switch c := container.(type) {
+	case map[string]any:
+		k, isStr := key.(string)
+		if !isStr {
+			return nil, false, fmt.Errorf("%w: cannot index map with %T", ErrInvalidOperation, key)
+		}
+		val, ok = c[k]
+		return val, ok, nil
+
+	case map[string]string:
+		k, isStr := key.(string)
+		if !isStr {
+			return nil, false, fmt.Errorf("%w: cannot index map with %T", ErrInvalidOperation, key)
+		}
+		val, ok = c[k]
+		return val, ok, nil
+
+	case []any:
+		i, err := sliceIndex(key, len(c))
+		if err != nil {
+			return nil, false, err
+		}
+		return c[i], true, nil
+	}
+
+	// typed maps and slices
+	v := reflect.ValueOf(container)
+	switch v.Kind() {
+	case reflect.Map:
+		k := reflect.ValueOf(key)
+		if !k.IsValid() || !k.Type().AssignableTo(v.Type().Key()) {
+			return nil, false, fmt.Errorf("%w: cannot index %T with %T", ErrInvalidOperation, container, key)
+		}
+		e := v.MapIndex(k)
+		if !e.IsValid() {
+			return nil, false, nil
+		}
+		return e.Interface(), true, nil
+
+	case reflect.Slice, reflect.Array:
+		i, err := sliceIndex(key, v.Len())
+		if err != nil {
+			return nil, false, err
+		}
+		return v.Index(i).Interface(), true, nil
+	}
+
+	return nil, false, fmt.Errorf("%w: cannot index %T", ErrInvalidOperation, container)
+}
+
+func sliceIndex(key any, length int) (int, error) {
+	var (
+		i       int
+		inRange bool
+	)
+	switch k := key.(type) {
+	case int:
+		i, inRange = k, k >= 0 && k < length
+	case int64:
+		i, inRange = int(k), k >= 0 && k < int64(length)
+	case uint:
+		i, inRange = int(k), k < uint(length)
+	case uint64:
+		i, inRange = int(k), k < uint64(length)
+	default:
+		return 0, fmt.Errorf("%w: cannot index array with %T", ErrInvalidOperation, key)
+	}
+	if !inRange {
+		return 0, &ErrIndexOutOfRange{Index: key, Len: length}
+	}
+	return i, nil
+}
+
+// forEachValue calls fn for each element of a slice or each value of a map until fn returns false.
+// A nil value is treated as an empty collection.
+func forEachValue(container any, fn func(el any) bool) error {
+	switch c := container.(type) {
+	case nil:
+		return nil
+	case []any:
+		for _, el := range c {
+			if !fn(el) {
+				return nil
+			}
+		}
+		return nil
+	case map[string]any:
+		for _, el := range c {
+			if !fn(el) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// typed maps and slices
+	v := reflect.ValueOf(container)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if !fn(v.Index(i).Interface()) {
+				return nil
+			}
+		}
+		return nil
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if !fn(iter.Value().Interface()) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: cannot iterate over %T", ErrInvalidOperation, container)
+}